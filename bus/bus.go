@@ -0,0 +1,356 @@
+// Package bus provides a typed in-process event bus with topic-based subscriptions.
+package bus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy defines how events are handled when a subscriber's buffer is full
+type OverflowPolicy int
+
+const (
+	// Block makes the publisher wait until the subscriber has room in its buffer
+	Block OverflowPolicy = iota
+	// DropNewest discards the event that is being published
+	DropNewest
+	// DropOldest discards the oldest buffered event to make room for the event that is being published
+	DropOldest
+)
+
+// Bus distributes published events to all subscribers of the event's topic
+type Bus[T any] struct {
+	mu        sync.RWMutex
+	subs      map[string]map[*Subscription[T]]struct{}
+	historyMu sync.Mutex
+	history   map[string][]T
+	replay    int
+}
+
+// BusOptions modify the behaviour of the Bus
+type BusOptions func(*busConfig) *busConfig
+
+type busConfig struct {
+	replay int
+}
+
+// WithReplay defines how many of the most recent events per topic are replayed to new subscribers. Default is 0
+func WithReplay(n int) BusOptions {
+	return func(cfg *busConfig) *busConfig {
+		cfg.replay = n
+		return cfg
+	}
+}
+
+// New returns a new Bus
+func New[T any](opts ...BusOptions) *Bus[T] {
+	cfg := &busConfig{}
+	for _, opt := range opts {
+		cfg = opt(cfg)
+	}
+
+	return &Bus[T]{
+		subs:    make(map[string]map[*Subscription[T]]struct{}),
+		history: make(map[string][]T),
+		replay:  cfg.replay,
+	}
+}
+
+// SubOptions modify the behaviour of a Subscription
+type SubOptions func(*subConfig) *subConfig
+
+type subConfig struct {
+	bufferSize int
+	policy     OverflowPolicy
+}
+
+// WithBufferSize defines how many events can be buffered for a subscriber. Default is 100. Panics if size is not positive
+func WithBufferSize(size int) SubOptions {
+	if size <= 0 {
+		panic("buffer size must be positive")
+	}
+
+	return func(cfg *subConfig) *subConfig {
+		cfg.bufferSize = size
+		return cfg
+	}
+}
+
+// WithOverflowPolicy defines how events are handled when the subscriber's buffer is full. Default is Block
+func WithOverflowPolicy(policy OverflowPolicy) SubOptions {
+	return func(cfg *subConfig) *subConfig {
+		cfg.policy = policy
+		return cfg
+	}
+}
+
+// Subscription receives the events published to a topic
+type Subscription[T any] struct {
+	topic  string
+	policy OverflowPolicy
+	events chan T
+	// ctx is cancelled when the subscription ends, either by the subscriber's context or by Unsubscribe
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+	dropped atomic.Uint64
+	blocked atomic.Uint64
+
+	// mu guards closing the events channel, so publishers never send on a closed channel
+	mu     sync.RWMutex
+	closed bool
+}
+
+// SubscriptionStats contains metrics to identify slow subscribers
+type SubscriptionStats struct {
+	Topic string
+	// Pending is the number of events that have not been read by the subscriber yet
+	Pending int
+	// Capacity is the size of the subscriber's buffer
+	Capacity int
+	// Dropped is the number of events that have been discarded because the buffer was full
+	Dropped uint64
+	// Blocked is the number of times a publisher had to wait because the buffer was full
+	Blocked uint64
+}
+
+// Events returns the channel of events for this subscription. It gets closed once the subscription ends.
+func (s *Subscription[T]) Events() <-chan T {
+	return s.events
+}
+
+// Done returns a channel that gets closed when the subscription has been removed from the bus
+func (s *Subscription[T]) Done() <-chan struct{} {
+	return s.done
+}
+
+// Unsubscribe removes the subscription from the bus and closes its events channel. It is safe to call multiple times.
+// Subscriptions with a context that never expires must be ended this way, otherwise they stay registered for the lifetime of the bus
+func (s *Subscription[T]) Unsubscribe() {
+	s.cancel()
+	<-s.done
+}
+
+// Stats returns the current metrics of the subscription
+func (s *Subscription[T]) Stats() SubscriptionStats {
+	return SubscriptionStats{
+		Topic:    s.topic,
+		Pending:  len(s.events),
+		Capacity: cap(s.events),
+		Dropped:  s.dropped.Load(),
+		Blocked:  s.blocked.Load(),
+	}
+}
+
+// Subscribe registers a new subscriber for the given topic. The subscription is removed from the bus when the context expires
+// or Unsubscribe is called.
+// If the bus is configured to replay events, the most recent events of the topic are delivered first.
+// If the subscriber's buffer is too small, only the most recent of those events are replayed.
+func (b *Bus[T]) Subscribe(ctx context.Context, topic string, opts ...SubOptions) *Subscription[T] {
+	cfg := &subConfig{bufferSize: 100, policy: Block}
+	for _, opt := range opts {
+		cfg = opt(cfg)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &Subscription[T]{
+		topic:  topic,
+		policy: cfg.policy,
+		events: make(chan T, cfg.bufferSize),
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	b.mu.Lock()
+	if _, ok := b.subs[topic]; !ok {
+		b.subs[topic] = make(map[*Subscription[T]]struct{})
+	}
+	b.subs[topic][sub] = struct{}{}
+
+	history := b.history[topic]
+	if skip := len(history) - cap(sub.events); skip > 0 {
+		sub.dropped.Add(uint64(skip))
+		history = history[skip:]
+	}
+
+	for _, event := range history {
+		sub.tryDeliver(event)
+	}
+	b.mu.Unlock()
+
+	// unlike a goroutine waiting on the context, this does not keep anything running while the subscription is active
+	context.AfterFunc(ctx, func() { b.unsubscribe(sub) })
+
+	return sub
+}
+
+// Publish delivers the event to all subscribers of the given topic. Depending on the subscribers' overflow policy,
+// it might block until there is room in their buffers. Subscribers are served independently, so a blocking subscriber
+// neither delays delivery to the other subscribers nor affects other topics and (un)subscriptions.
+// If the context expires while blocked, the event is still delivered to all other subscribers and an error is returned.
+// The event is recorded for replay in any case.
+func (b *Bus[T]) Publish(ctx context.Context, topic string, event T) error {
+	// the event is recorded while holding the lock, so subscribers that join after the snapshot still receive it via replay
+	b.mu.RLock()
+	subs := make([]*Subscription[T], 0, len(b.subs[topic]))
+	for sub := range b.subs[topic] {
+		subs = append(subs, sub)
+	}
+
+	if b.replay > 0 {
+		b.record(topic, event)
+	}
+	b.mu.RUnlock()
+
+	// deliver without holding the bus lock, so a blocking subscriber cannot stall other topics or (un)subscriptions
+	var full []*Subscription[T]
+	for _, sub := range subs {
+		if !sub.offer(event) {
+			full = append(full, sub)
+		}
+	}
+
+	// wait for all full subscribers with the Block policy concurrently, so they cannot delay each other
+	var (
+		wg     sync.WaitGroup
+		failed atomic.Int64
+	)
+	for _, sub := range full {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := sub.deliver(ctx, event); err != nil {
+				failed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := failed.Load(); n > 0 {
+		return fmt.Errorf("event was not delivered to %d of %d subscribers: %w", n, len(subs), ctx.Err())
+	}
+
+	return nil
+}
+
+// Stats returns the metrics of all current subscriptions
+func (b *Bus[T]) Stats() []SubscriptionStats {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	var stats []SubscriptionStats
+	for _, subs := range b.subs {
+		for sub := range subs {
+			stats = append(stats, sub.Stats())
+		}
+	}
+
+	return stats
+}
+
+// SlowSubscribers returns the metrics of all subscriptions that have dropped events, blocked publishers or have a full buffer
+func (b *Bus[T]) SlowSubscribers() []SubscriptionStats {
+	var slow []SubscriptionStats
+	for _, stats := range b.Stats() {
+		if stats.Dropped > 0 || stats.Blocked > 0 || stats.Pending == stats.Capacity {
+			slow = append(slow, stats)
+		}
+	}
+
+	return slow
+}
+
+// record keeps the most recent events of a topic for replay. Publishers only hold the read lock, so history access is guarded separately.
+func (b *Bus[T]) record(topic string, event T) {
+	b.historyMu.Lock()
+	defer b.historyMu.Unlock()
+
+	h := append(b.history[topic], event)
+	if len(h) > b.replay {
+		h = h[len(h)-b.replay:]
+	}
+	b.history[topic] = h
+}
+
+func (b *Bus[T]) unsubscribe(sub *Subscription[T]) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.subs[sub.topic], sub)
+	if len(b.subs[sub.topic]) == 0 {
+		delete(b.subs, sub.topic)
+	}
+
+	sub.close()
+}
+
+// close closes the subscription's channels. Blocked publishers give up as soon as the subscription's context is done,
+// which is what triggers the unsubscription, so this never waits on a slow publisher.
+func (s *Subscription[T]) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	close(s.events)
+	close(s.done)
+}
+
+// offer delivers the event without blocking. Returns false if the subscriber's buffer is full and it uses the Block policy
+func (s *Subscription[T]) offer(event T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// the subscription might have been removed after the publisher took its snapshot
+	return s.closed || s.tryDeliver(event)
+}
+
+// deliver blocks until the event is delivered, the subscription ends or the context expires
+func (s *Subscription[T]) deliver(ctx context.Context, event T) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed || s.tryDeliver(event) {
+		return nil
+	}
+
+	s.blocked.Add(1)
+	select {
+	case s.events <- event:
+		return nil
+	case <-s.ctx.Done():
+		// the subscriber is leaving, so the event can be discarded
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// tryDeliver sends the event without blocking, applying the drop policies if the buffer is full.
+// Returns false if the event could not be delivered because the subscriber uses the Block policy.
+func (s *Subscription[T]) tryDeliver(event T) bool {
+	for {
+		select {
+		case s.events <- event:
+			return true
+		default:
+		}
+
+		switch s.policy {
+		case DropNewest:
+			s.dropped.Add(1)
+			return true
+		case DropOldest:
+			// the subscriber might read concurrently, so there is no guarantee an event gets discarded here
+			select {
+			case <-s.events:
+				s.dropped.Add(1)
+			default:
+			}
+		default:
+			return false
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package bus_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/utils/bus"
+	"github.com/axelarnetwork/utils/chans"
+	testutils "github.com/axelarnetwork/utils/test"
+)
+
+func TestBus_PublishSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := bus.New[int]()
+	sub1 := b.Subscribe(ctx, "blocks")
+	sub2 := b.Subscribe(ctx, "blocks")
+	other := b.Subscribe(ctx, "txs")
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, b.Publish(context.Background(), "blocks", i))
+	}
+
+	assert.Equal(t, 5, chans.DrainOpen(sub1.Events()))
+	assert.Equal(t, 5, chans.DrainOpen(sub2.Events()))
+	assert.Equal(t, 0, chans.DrainOpen(other.Events()))
+}
+
+func TestBus_UnsubscribeViaContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	b := bus.New[int]()
+	sub := b.Subscribe(ctx, "blocks")
+	assert.Len(t, b.Stats(), 1)
+
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		<-sub.Done()
+
+		_, ok := <-sub.Events()
+		assert.False(t, ok)
+	}()
+	testutils.FailOnTimeout(t, done, 1*time.Second)
+
+	assert.Empty(t, b.Stats())
+	assert.NoError(t, b.Publish(context.Background(), "blocks", 1))
+}
+
+func TestBus_OverflowPolicies(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := bus.New[int]()
+	dropNewest := b.Subscribe(ctx, "blocks", bus.WithBufferSize(2), bus.WithOverflowPolicy(bus.DropNewest))
+	dropOldest := b.Subscribe(ctx, "blocks", bus.WithBufferSize(2), bus.WithOverflowPolicy(bus.DropOldest))
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, b.Publish(context.Background(), "blocks", i))
+	}
+
+	assert.Equal(t, []int{0, 1}, []int{<-dropNewest.Events(), <-dropNewest.Events()})
+	assert.Equal(t, []int{3, 4}, []int{<-dropOldest.Events(), <-dropOldest.Events()})
+	assert.EqualValues(t, 3, dropNewest.Stats().Dropped)
+	assert.EqualValues(t, 3, dropOldest.Stats().Dropped)
+}
+
+func TestBus_Block(t *testing.T) {
+	subCtx, cancelSub := context.WithCancel(context.Background())
+	defer cancelSub()
+
+	b := bus.New[int]()
+	sub := b.Subscribe(subCtx, "blocks", bus.WithBufferSize(1))
+
+	require.NoError(t, b.Publish(context.Background(), "blocks", 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, b.Publish(ctx, "blocks", 2), context.DeadlineExceeded)
+
+	slow := b.SlowSubscribers()
+	require.Len(t, slow, 1)
+	assert.Equal(t, bus.SubscriptionStats{Topic: "blocks", Pending: 1, Capacity: 1, Blocked: 1}, slow[0])
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, b.Publish(context.Background(), "blocks", 3))
+	}()
+
+	assert.Equal(t, 1, <-sub.Events())
+	testutils.FailOnTimeout(t, done, 1*time.Second)
+	assert.Equal(t, 3, <-sub.Events())
+}
+
+func TestBus_BlockedSubscriberDoesNotStallOtherTopics(t *testing.T) {
+	slowCtx, cancelSlow := context.WithCancel(context.Background())
+	defer cancelSlow()
+
+	b := bus.New[int]()
+	slow := b.Subscribe(slowCtx, "a", bus.WithBufferSize(1))
+	require.NoError(t, b.Publish(context.Background(), "a", 1))
+
+	blocked := make(chan struct{})
+	go func() {
+		defer close(blocked)
+		assert.NoError(t, b.Publish(context.Background(), "a", 2))
+	}()
+
+	// wait until the publisher is blocked on the full buffer
+	assert.Eventually(t, func() bool { return slow.Stats().Blocked == 1 }, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		other := b.Subscribe(ctx, "b")
+		assert.NoError(t, b.Publish(context.Background(), "b", 3))
+		assert.Equal(t, 3, <-other.Events())
+
+		assert.NoError(t, b.Publish(context.Background(), "c", 4))
+	}()
+	testutils.FailOnTimeout(t, done, 1*time.Second)
+
+	// unsubscribing the slow subscriber releases the blocked publisher
+	cancelSlow()
+	testutils.FailOnTimeout(t, slow.Done(), 1*time.Second)
+	testutils.FailOnTimeout(t, blocked, 1*time.Second)
+}
+
+func TestBus_BlockedSubscriberDoesNotDelayOthers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := bus.New[int]()
+	stuck := b.Subscribe(ctx, "blocks", bus.WithBufferSize(1))
+	other := b.Subscribe(ctx, "blocks", bus.WithBufferSize(1))
+	dropping := b.Subscribe(ctx, "blocks", bus.WithBufferSize(1), bus.WithOverflowPolicy(bus.DropOldest))
+
+	require.NoError(t, b.Publish(context.Background(), "blocks", 1))
+	assert.Equal(t, 1, <-other.Events())
+
+	publishCtx, cancelPublish := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelPublish()
+
+	// only the stuck subscriber misses the event
+	err := b.Publish(publishCtx, "blocks", 2)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "1 of 3 subscribers")
+
+	assert.Equal(t, 2, <-other.Events())
+	assert.Equal(t, 2, <-dropping.Events())
+	assert.Equal(t, 1, <-stuck.Events())
+}
+
+func TestBus_Unsubscribe(t *testing.T) {
+	b := bus.New[int]()
+	sub := b.Subscribe(context.Background(), "blocks", bus.WithBufferSize(1))
+	require.NoError(t, b.Publish(context.Background(), "blocks", 1))
+
+	// a publisher blocked on the full buffer is released when the subscription ends
+	published := make(chan struct{})
+	go func() {
+		defer close(published)
+		assert.NoError(t, b.Publish(context.Background(), "blocks", 2))
+	}()
+	assert.Eventually(t, func() bool { return sub.Stats().Blocked == 1 }, time.Second, time.Millisecond)
+
+	sub.Unsubscribe()
+	testutils.FailOnTimeout(t, published, 1*time.Second)
+	assert.Empty(t, b.Stats())
+
+	assert.Equal(t, 1, <-sub.Events())
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+
+	// unsubscribing again is a no-op
+	sub.Unsubscribe()
+}
+
+func TestBus_InvalidBufferSize(t *testing.T) {
+	assert.Panics(t, func() { bus.WithBufferSize(0) })
+	assert.Panics(t, func() { bus.WithBufferSize(-1) })
+}
+
+func TestBus_Replay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := bus.New[int](bus.WithReplay(3))
+	for i := 0; i < 5; i++ {
+		require.NoError(t, b.Publish(context.Background(), "blocks", i))
+	}
+
+	late := b.Subscribe(ctx, "blocks")
+	require.NoError(t, b.Publish(context.Background(), "blocks", 5))
+
+	assert.Equal(t, []int{2, 3, 4, 5}, []int{<-late.Events(), <-late.Events(), <-late.Events(), <-late.Events()})
+
+	small := b.Subscribe(ctx, "blocks", bus.WithBufferSize(1))
+	assert.Equal(t, 5, <-small.Events())
+	assert.EqualValues(t, 2, small.Stats().Dropped)
+}
@@ -0,0 +1,209 @@
+package chans
+
+import (
+	"container/heap"
+	"sync"
+	"sync/atomic"
+)
+
+// UnboundedChannel is a channel with an elastic internal buffer. Writes to In() never block on slow readers of Out().
+type UnboundedChannel[T any] struct {
+	*buffered[T]
+}
+
+// Unbounded returns a new channel with an elastic internal buffer.
+// Close In() to release the channel, Out() gets closed once all buffered elements have been read.
+func Unbounded[T any]() UnboundedChannel[T] {
+	return UnboundedChannel[T]{newBuffered[T](&fifo[T]{})}
+}
+
+// RingBufferChannel is a channel with a fixed-size internal buffer. Writes to In() never block on slow readers of Out(),
+// instead the oldest buffered element is dropped when the buffer is full.
+type RingBufferChannel[T any] struct {
+	*buffered[T]
+	ring *ring[T]
+}
+
+// RingBuffer returns a new channel with an internal buffer that holds at most size elements. Panics if size is not positive.
+// Close In() to release the channel, Out() gets closed once all buffered elements have been read.
+func RingBuffer[T any](size int) RingBufferChannel[T] {
+	if size <= 0 {
+		panic("ring buffer size must be positive")
+	}
+
+	r := &ring[T]{items: make([]T, size)}
+	return RingBufferChannel[T]{buffered: newBuffered[T](r), ring: r}
+}
+
+// Dropped returns the number of elements that have been overwritten because the buffer was full
+func (c RingBufferChannel[T]) Dropped() uint64 {
+	return c.ring.dropped.Load()
+}
+
+// PriorityChannel is a channel with an elastic internal buffer that emits buffered elements ordered by priority.
+// Writes to In() never block on slow readers of Out().
+type PriorityChannel[T any] struct {
+	*buffered[T]
+}
+
+// Priority returns a new channel that emits buffered elements ordered by the given less function, i.e. if less(a, b) is true, a is emitted before b.
+// Close In() to release the channel, Out() gets closed once all buffered elements have been read.
+func Priority[T any](less func(a, b T) bool) PriorityChannel[T] {
+	return PriorityChannel[T]{newBuffered[T](&priorityQueue[T]{less: less})}
+}
+
+type queue[T any] interface {
+	push(x T)
+	peek() T
+	pop()
+	len() int
+}
+
+// buffered moves elements from its input to its output channel through an internal queue
+type buffered[T any] struct {
+	in    chan T
+	out   chan T
+	mu    sync.Mutex
+	queue queue[T]
+}
+
+func newBuffered[T any](q queue[T]) *buffered[T] {
+	b := &buffered[T]{
+		in:    make(chan T),
+		out:   make(chan T),
+		queue: q,
+	}
+
+	go b.run()
+
+	return b
+}
+
+// In returns the writing end of the channel. Close it when no more elements will be written.
+func (b *buffered[T]) In() chan<- T {
+	return b.in
+}
+
+// Out returns the reading end of the channel
+func (b *buffered[T]) Out() <-chan T {
+	return b.out
+}
+
+// Len returns the number of elements currently held in the internal buffer
+func (b *buffered[T]) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.queue.len()
+}
+
+func (b *buffered[T]) run() {
+	defer close(b.out)
+
+	in := b.in
+	for {
+		var (
+			out  chan T
+			next T
+		)
+
+		b.mu.Lock()
+		if b.queue.len() > 0 {
+			out = b.out
+			next = b.queue.peek()
+		}
+		b.mu.Unlock()
+
+		// input is closed and everything has been read
+		if in == nil && out == nil {
+			return
+		}
+
+		select {
+		case x, ok := <-in:
+			if !ok {
+				in = nil
+				continue
+			}
+
+			b.mu.Lock()
+			b.queue.push(x)
+			b.mu.Unlock()
+		case out <- next:
+			b.mu.Lock()
+			b.queue.pop()
+			b.mu.Unlock()
+		}
+	}
+}
+
+type fifo[T any] struct {
+	items []T
+}
+
+func (q *fifo[T]) push(x T) { q.items = append(q.items, x) }
+func (q *fifo[T]) peek() T  { return q.items[0] }
+func (q *fifo[T]) len() int { return len(q.items) }
+
+func (q *fifo[T]) pop() {
+	// clear the reference so the element can be garbage collected
+	q.items[0] = *new(T)
+	q.items = q.items[1:]
+
+	// release the backing array once the queue is empty
+	if len(q.items) == 0 {
+		q.items = nil
+	}
+}
+
+type ring[T any] struct {
+	items   []T
+	head    int
+	count   int
+	dropped atomic.Uint64
+}
+
+func (q *ring[T]) push(x T) {
+	if q.count == len(q.items) {
+		q.pop()
+		q.dropped.Add(1)
+	}
+
+	q.items[(q.head+q.count)%len(q.items)] = x
+	q.count++
+}
+
+func (q *ring[T]) peek() T  { return q.items[q.head] }
+func (q *ring[T]) len() int { return q.count }
+
+func (q *ring[T]) pop() {
+	q.items[q.head] = *new(T)
+	q.head = (q.head + 1) % len(q.items)
+	q.count--
+}
+
+type priorityQueue[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (q *priorityQueue[T]) push(x T) { heap.Push((*heapAdapter[T])(q), x) }
+func (q *priorityQueue[T]) peek() T  { return q.items[0] }
+func (q *priorityQueue[T]) pop()     { heap.Pop((*heapAdapter[T])(q)) }
+func (q *priorityQueue[T]) len() int { return len(q.items) }
+
+// heapAdapter implements heap.Interface for priorityQueue
+type heapAdapter[T any] priorityQueue[T]
+
+func (h *heapAdapter[T]) Len() int           { return len(h.items) }
+func (h *heapAdapter[T]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h *heapAdapter[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *heapAdapter[T]) Push(x any)         { h.items = append(h.items, x.(T)) }
+
+func (h *heapAdapter[T]) Pop() any {
+	last := len(h.items) - 1
+	x := h.items[last]
+	h.items[last] = *new(T)
+	h.items = h.items[:last]
+	return x
+}
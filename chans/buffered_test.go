@@ -0,0 +1,83 @@
+package chans_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/chans"
+	testutils "github.com/axelarnetwork/utils/test"
+)
+
+func TestUnbounded(t *testing.T) {
+	done := make(chan struct{})
+
+	c := chans.Unbounded[int]()
+	go func() {
+		defer close(done)
+
+		// writing never blocks even though nobody reads yet
+		for i := 0; i < 1000; i++ {
+			c.In() <- i
+		}
+		close(c.In())
+	}()
+
+	testutils.FailOnTimeout(t, done, 1*time.Second)
+	assert.Eventually(t, func() bool { return c.Len() == 1000 }, time.Second, 10*time.Millisecond)
+
+	out, err := chans.Collect(context.Background(), c.Out())
+	assert.NoError(t, err)
+	assert.Len(t, out, 1000)
+	for i, x := range out {
+		assert.Equal(t, i, x)
+	}
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestRingBuffer(t *testing.T) {
+	done := make(chan struct{})
+
+	c := chans.RingBuffer[int](3)
+	go func() {
+		defer close(done)
+
+		for i := 0; i < 10; i++ {
+			c.In() <- i
+		}
+		close(c.In())
+	}()
+
+	testutils.FailOnTimeout(t, done, 1*time.Second)
+	assert.Eventually(t, func() bool { return c.Len() == 3 }, time.Second, 10*time.Millisecond)
+
+	out, err := chans.Collect(context.Background(), c.Out())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{7, 8, 9}, out)
+	assert.EqualValues(t, 7, c.Dropped())
+
+	assert.Panics(t, func() { chans.RingBuffer[int](0) })
+}
+
+func TestPriority(t *testing.T) {
+	done := make(chan struct{})
+
+	c := chans.Priority(func(a, b int) bool { return a > b })
+	go func() {
+		defer close(done)
+
+		for _, i := range []int{5, 1, 9, 3, 7} {
+			c.In() <- i
+		}
+		close(c.In())
+	}()
+
+	testutils.FailOnTimeout(t, done, 1*time.Second)
+	assert.Eventually(t, func() bool { return c.Len() == 5 }, time.Second, 10*time.Millisecond)
+
+	out, err := chans.Collect(context.Background(), c.Out())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{9, 7, 5, 3, 1}, out)
+}
@@ -0,0 +1,155 @@
+package chans
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-errors/errors"
+)
+
+// Collect blocks until the source channel is closed and returns all received elements in order.
+// Returns the elements received so far and the context error if the context expires first.
+func Collect[T any](ctx context.Context, source <-chan T) ([]T, error) {
+	var out []T
+	err := consume(ctx, source, func(x T) bool {
+		out = append(out, x)
+		return true
+	})
+
+	return out, err
+}
+
+// Reduce performs a reduction to a single value of all elements in the source channel according to the given function.
+// Blocks until the source channel is closed. Returns the current value and the context error if the context expires first.
+func Reduce[T, S any](ctx context.Context, source <-chan T, initial S, f func(current S, element T) S) (S, error) {
+	v := initial
+	err := consume(ctx, source, func(x T) bool {
+		v = f(v, x)
+		return true
+	})
+
+	return v, err
+}
+
+// First returns the next element from the source channel.
+// Returns false if the channel is closed or the context expires before an element is available.
+func First[T any](ctx context.Context, source <-chan T) (T, bool) {
+	return Find(ctx, source, func(T) bool { return true })
+}
+
+// Find returns the first element from the source channel that matches the predicate. Elements that do not match are discarded.
+// Returns false if the channel is closed or the context expires before a matching element is found.
+func Find[T any](ctx context.Context, source <-chan T, predicate func(T) bool) (T, bool) {
+	var (
+		found T
+		ok    bool
+	)
+
+	_ = consume(ctx, source, func(x T) bool {
+		if predicate(x) {
+			found, ok = x, true
+			return false
+		}
+		return true
+	})
+
+	return found, ok
+}
+
+// Any tests if any of the elements of the source channel match the predicate. Returns as soon as a match is found,
+// otherwise blocks until the source channel is closed. Returns the context error if the context expires first.
+func Any[T any](ctx context.Context, source <-chan T, predicate func(T) bool) (bool, error) {
+	found := false
+	err := consume(ctx, source, func(x T) bool {
+		found = predicate(x)
+		return !found
+	})
+	if found {
+		return true, nil
+	}
+
+	return false, err
+}
+
+// All tests if all of the elements of the source channel match the predicate. Returns as soon as a mismatch is found,
+// otherwise blocks until the source channel is closed. Returns the context error if the context expires first.
+func All[T any](ctx context.Context, source <-chan T, predicate func(T) bool) (bool, error) {
+	all := true
+	err := consume(ctx, source, func(x T) bool {
+		all = predicate(x)
+		return all
+	})
+	if !all {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// Count blocks until the source channel is closed and returns the number of received elements.
+// Returns the count so far and the context error if the context expires first.
+func Count[T any](ctx context.Context, source <-chan T) (int, error) {
+	return Reduce(ctx, source, 0, func(count int, _ T) int { return count + 1 })
+}
+
+// ToMap blocks until the source channel is closed and returns a map with keys associated by the lookup function.
+// Returns an error if strictUniqueness is set and a key collides, otherwise overrides values for colliding keys.
+// Returns the map populated so far and the context error if the context expires first.
+func ToMap[T any, K comparable](ctx context.Context, source <-chan T, lookup func(T) K, strictUniqueness ...bool) (map[K]T, error) {
+	m := make(map[K]T)
+
+	strict := len(strictUniqueness) > 0 && strictUniqueness[0]
+
+	var dupErr error
+	err := consume(ctx, source, func(x T) bool {
+		key := lookup(x)
+		if strict {
+			if value, ok := m[key]; ok {
+				dupErr = fmt.Errorf("key %v is not unique, points to %v and %v", key, value, x)
+				return false
+			}
+		}
+
+		m[key] = x
+		return true
+	})
+	if dupErr != nil {
+		return m, dupErr
+	}
+
+	return m, err
+}
+
+// ForEachSync performs the given function on every element in the channel. In contrast to ForEach,
+// it blocks until the source channel is closed. Returns an error if f panics or the context expires.
+func ForEachSync[T any](ctx context.Context, source <-chan T, f func(T)) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("function panicked: %s\n%s", r, errors.Wrap(r, 1).Stack())
+		}
+	}()
+
+	return consume(ctx, source, func(x T) bool {
+		f(x)
+		return true
+	})
+}
+
+// consume calls f for each element of the source channel until f returns false, the channel is closed or the context expires.
+// Only returns an error if the context expires.
+func consume[T any](ctx context.Context, source <-chan T, f func(T) bool) error {
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case x, ok := <-source:
+			if !ok || !f(x) {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package chans_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/chans"
+)
+
+func cancelled() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+// counter produces the integers in [from, to] until the context expires
+func counter(ctx context.Context, from, to int) <-chan int {
+	out := make(chan int)
+
+	go func() {
+		defer close(out)
+
+		for i := from; i <= to; i++ {
+			if !chans.Push(ctx, out, i) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+func TestCollect(t *testing.T) {
+	out, err := chans.Collect(context.Background(), chans.Range(1, 5))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, out)
+
+	out, err = chans.Collect(context.Background(), chans.Empty[int]())
+	assert.NoError(t, err)
+	assert.Empty(t, out)
+
+	_, err = chans.Collect(cancelled(), make(chan int))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestCollect_Timeout(t *testing.T) {
+	source := make(chan int, 2)
+	source <- 1
+	source <- 2
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	out, err := chans.Collect(ctx, source)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, []int{1, 2}, out)
+}
+
+func TestReduce(t *testing.T) {
+	sum, err := chans.Reduce(context.Background(), chans.Range(1, 100), 0, func(v int, i int) int { return v + i })
+	assert.NoError(t, err)
+	assert.Equal(t, 5050, sum)
+
+	_, err = chans.Reduce(cancelled(), make(chan int), 0, func(v int, i int) int { return v + i })
+	assert.Error(t, err)
+}
+
+func TestFirst(t *testing.T) {
+	x, ok := chans.First(context.Background(), chans.FromValues(3, 2, 1))
+	assert.True(t, ok)
+	assert.Equal(t, 3, x)
+
+	_, ok = chans.First(context.Background(), chans.Empty[int]())
+	assert.False(t, ok)
+
+	_, ok = chans.First(cancelled(), chans.FromValues(3, 2, 1))
+	assert.False(t, ok)
+}
+
+func TestFind(t *testing.T) {
+	source := chans.FromValues(1, 3, 4, 5, 6)
+
+	x, ok := chans.Find(context.Background(), source, func(i int) bool { return i%2 == 0 })
+	assert.True(t, ok)
+	assert.Equal(t, 4, x)
+
+	x, ok = chans.Find(context.Background(), source, func(i int) bool { return i%2 == 0 })
+	assert.True(t, ok)
+	assert.Equal(t, 6, x)
+
+	_, ok = chans.Find(context.Background(), source, func(i int) bool { return i%2 == 0 })
+	assert.False(t, ok)
+}
+
+func TestAny(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	found, err := chans.Any(context.Background(), chans.FromValues(1, 3, 4), isEven)
+	assert.NoError(t, err)
+	assert.True(t, found)
+
+	found, err = chans.Any(context.Background(), chans.FromValues(1, 3, 5), isEven)
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// returns early without waiting for the channel to close
+	source := make(chan int, 1)
+	source <- 2
+	found, err = chans.Any(context.Background(), source, isEven)
+	assert.NoError(t, err)
+	assert.True(t, found)
+
+	_, err = chans.Any(cancelled(), make(chan int), isEven)
+	assert.Error(t, err)
+}
+
+func TestAll(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	all, err := chans.All(context.Background(), chans.FromValues(2, 4, 6), isEven)
+	assert.NoError(t, err)
+	assert.True(t, all)
+
+	all, err = chans.All(context.Background(), chans.FromValues(2, 3, 6), isEven)
+	assert.NoError(t, err)
+	assert.False(t, all)
+
+	all, err = chans.All(cancelled(), make(chan int), isEven)
+	assert.Error(t, err)
+	assert.False(t, all)
+}
+
+func TestCount(t *testing.T) {
+	count, err := chans.Count(context.Background(), chans.Range(1, 42))
+	assert.NoError(t, err)
+	assert.Equal(t, 42, count)
+}
+
+func TestToMap(t *testing.T) {
+	m, err := chans.ToMap(context.Background(), chans.FromValues(0, 3, 2, 7, 3), strconv.Itoa)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"0": 0, "2": 2, "3": 3, "7": 7}, m)
+
+	_, err = chans.ToMap(context.Background(), chans.FromValues(0, 3, 2, 7, 3), strconv.Itoa, true)
+	assert.Error(t, err)
+}
+
+func TestForEachSync(t *testing.T) {
+	total := 0
+	err := chans.ForEachSync(context.Background(), chans.Range(1, 100), func(n int) { total += n })
+	assert.NoError(t, err)
+	assert.Equal(t, 5050, total)
+
+	assertNoLeakedGoroutines(t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// the producer is left blocked after the panic, so it needs to be released via the context
+		err := chans.ForEachSync(context.Background(), counter(ctx, 1, 100), func(n int) {
+			if n == 50 {
+				panic("unexpected value")
+			}
+		})
+		assert.ErrorContains(t, err, "unexpected value")
+	})
+
+	err = chans.ForEachSync(cancelled(), make(chan int), func(int) {})
+	assert.ErrorIs(t, err, context.Canceled)
+}
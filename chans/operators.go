@@ -0,0 +1,201 @@
+package chans
+
+import (
+	"context"
+
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// Pair holds two values of potentially different types. It is the same type as slices.Pair, so zipped channels and slices can be used interchangeably
+type Pair[A, B any] = slices.Pair[A, B]
+
+// Producer starts producing elements on a new channel. It must stop and close the channel once the given context expires,
+// e.g. func(ctx context.Context) <-chan *big.Int { return RangeBigStep(ctx, from, to, step) }.
+// Operators that stop reading their source early take a Producer, so they can release it when they are done
+type Producer[T any] func(ctx context.Context) <-chan T
+
+// Of wraps an existing channel as a Producer. Operators cannot stop whatever writes to such a channel,
+// so it should only be used for channels that are buffered or closed by their writer
+func Of[T any](source <-chan T) Producer[T] {
+	return func(context.Context) <-chan T { return source }
+}
+
+// Take returns a new channel that contains at most the first n elements of the source.
+// The source is started with a context derived from ctx, which is cancelled as soon as Take stops reading,
+// so the producer is released once n elements have been taken. Runs until the context expires, the source channel is closed,
+// or n elements have been taken.
+func Take[T any](ctx context.Context, source Producer[T], n int) <-chan T {
+	ctx, cancel := context.WithCancel(ctx)
+	src := source(ctx)
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+		_ = consume(ctx, src, func(x T) bool {
+			if !Push(ctx, out, x) {
+				return false
+			}
+
+			taken++
+			return taken < n
+		})
+	}()
+
+	return out
+}
+
+// Skip returns a new channel that contains all elements of the source channel except for the first n.
+// Runs until the context expires or the source channel is closed.
+func Skip[T any](ctx context.Context, source <-chan T, n int) <-chan T {
+	skipped := 0
+	return SkipWhile(ctx, source, func(T) bool {
+		if skipped < n {
+			skipped++
+			return true
+		}
+		return false
+	})
+}
+
+// TakeWhile returns a new channel that contains the elements of the source up to (excluding) the first element
+// that does not match the predicate. Like Take, it cancels the source's context after the first mismatch to release the producer.
+// Runs until the context expires, the source channel is closed or the predicate fails.
+func TakeWhile[T any](ctx context.Context, source Producer[T], predicate func(T) bool) <-chan T {
+	ctx, cancel := context.WithCancel(ctx)
+	src := source(ctx)
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		_ = consume(ctx, src, func(x T) bool {
+			return predicate(x) && Push(ctx, out, x)
+		})
+	}()
+
+	return out
+}
+
+// SkipWhile returns a new channel that discards elements of the source channel as long as they match the predicate
+// and contains all elements from the first mismatch onwards. Runs until the context expires or the source channel is closed.
+func SkipWhile[T any](ctx context.Context, source <-chan T, predicate func(T) bool) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		skipping := true
+		_ = consume(ctx, source, func(x T) bool {
+			if skipping && predicate(x) {
+				return true
+			}
+
+			skipping = false
+			return Push(ctx, out, x)
+		})
+	}()
+
+	return out
+}
+
+// Zip returns a new channel that pairs up the elements of both sources in order.
+// Runs until the context expires or either of the source channels is closed. Like Take, it then cancels the sources' context
+// to release the producer of the remaining source.
+func Zip[A, B any](ctx context.Context, first Producer[A], second Producer[B]) <-chan Pair[A, B] {
+	return ZipWith(ctx, first, second, func(a A, b B) Pair[A, B] { return Pair[A, B]{First: a, Second: b} })
+}
+
+// ZipWith returns a new channel that combines the elements of both sources in order with the given function.
+// Runs until the context expires or either of the source channels is closed. Like Take, it then cancels the sources' context
+// to release the producer of the remaining source.
+func ZipWith[A, B, S any](ctx context.Context, first Producer[A], second Producer[B], f func(A, B) S) <-chan S {
+	ctx, cancel := context.WithCancel(ctx)
+	firstSrc, secondSrc := first(ctx), second(ctx)
+	out := make(chan S)
+
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		for {
+			a, ok := receive(ctx, firstSrc)
+			if !ok {
+				return
+			}
+
+			b, ok := receive(ctx, secondSrc)
+			if !ok {
+				return
+			}
+
+			if !Push(ctx, out, f(a, b)) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Enumerate returns a new channel that pairs each element of the source channel with its zero-based index.
+// Runs until the context expires or the source channel is closed.
+func Enumerate[T any](ctx context.Context, source <-chan T) <-chan Pair[int, T] {
+	out := make(chan Pair[int, T])
+
+	go func() {
+		defer close(out)
+
+		i := 0
+		_ = consume(ctx, source, func(x T) bool {
+			ok := Push(ctx, out, Pair[int, T]{First: i, Second: x})
+			i++
+			return ok
+		})
+	}()
+
+	return out
+}
+
+// Distinct returns a new channel where duplicate elements of the source channel are removed.
+// All forwarded elements are kept in memory to detect duplicates. Runs until the context expires or the source channel is closed.
+func Distinct[T comparable](ctx context.Context, source <-chan T) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		seen := make(map[T]struct{})
+		_ = consume(ctx, source, func(x T) bool {
+			if _, ok := seen[x]; ok {
+				return true
+			}
+
+			seen[x] = struct{}{}
+			return Push(ctx, out, x)
+		})
+	}()
+
+	return out
+}
+
+// receive gets the next element from the given channel. Returns false if the channel is closed or the context expires.
+func receive[T any](ctx context.Context, c <-chan T) (T, bool) {
+	if ctx.Err() != nil {
+		return *new(T), false
+	}
+
+	select {
+	case <-ctx.Done():
+		return *new(T), false
+	case x, ok := <-c:
+		return x, ok
+	}
+}
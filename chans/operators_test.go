@@ -0,0 +1,164 @@
+package chans_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/utils/chans"
+	testutils "github.com/axelarnetwork/utils/test"
+)
+
+func TestTake(t *testing.T) {
+	assertNoLeakedGoroutines(t, func() {
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+
+			blocks := chans.Take(context.Background(), producer(100, 1_000_000_000), 3)
+			out, err := chans.Collect(context.Background(), blocks)
+			require.NoError(t, err)
+			assert.Equal(t, []int{100, 101, 102}, out)
+		}()
+
+		testutils.FailOnTimeout(t, done, 1*time.Second)
+
+		out, err := chans.Collect(context.Background(), chans.Take(context.Background(), producer(1, 3), 10))
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, out)
+
+		out, err = chans.Collect(context.Background(), chans.Take(context.Background(), producer(1, 3), 0))
+		assert.NoError(t, err)
+		assert.Empty(t, out)
+	})
+}
+
+func TestTake_ReleasesProducer(t *testing.T) {
+	var producerCtx context.Context
+	source := func(ctx context.Context) <-chan int {
+		producerCtx = ctx
+		return counter(ctx, 0, 1_000_000_000)
+	}
+
+	out, err := chans.Collect(context.Background(), chans.Take(context.Background(), source, 2))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, out)
+
+	testutils.FailOnTimeout(t, producerCtx.Done(), 1*time.Second)
+}
+
+func TestTake_StopsReadingSource(t *testing.T) {
+	source := make(chan int, 10)
+	for i := 0; i < 10; i++ {
+		source <- i
+	}
+
+	out, err := chans.Collect(context.Background(), chans.Take(context.Background(), chans.Of(source), 4))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3}, out)
+	assert.Len(t, source, 6)
+}
+
+func TestTake_CancelledContext(t *testing.T) {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		assert.Equal(t, 0, chans.Drain(chans.Take(ctx, chans.Of(make(chan int)), 5)))
+	}()
+
+	testutils.FailOnTimeout(t, done, 1*time.Second)
+}
+
+func TestSkip(t *testing.T) {
+	out, err := chans.Collect(context.Background(), chans.Skip(context.Background(), chans.Range(1, 6), 4))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{5, 6}, out)
+
+	out, err = chans.Collect(context.Background(), chans.Skip(context.Background(), chans.Range(1, 3), 4))
+	assert.NoError(t, err)
+	assert.Empty(t, out)
+}
+
+func TestTakeWhile(t *testing.T) {
+	source := chans.FromValues(1, 2, 3, 10, 4, 5)
+
+	out, err := chans.Collect(context.Background(), chans.TakeWhile(context.Background(), chans.Of(source), func(i int) bool { return i < 5 }))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, out)
+
+	// the first mismatch is consumed, everything after is left untouched
+	assert.Len(t, source, 2)
+
+	assertNoLeakedGoroutines(t, func() {
+		out, err := chans.Collect(context.Background(), chans.TakeWhile(context.Background(), producer(0, 1_000_000_000), func(i int) bool { return i < 3 }))
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 1, 2}, out)
+	})
+}
+
+func TestSkipWhile(t *testing.T) {
+	source := chans.FromValues(1, 2, 3, 10, 4, 5)
+
+	out, err := chans.Collect(context.Background(), chans.SkipWhile(context.Background(), source, func(i int) bool { return i < 5 }))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{10, 4, 5}, out)
+}
+
+func TestZip(t *testing.T) {
+	assertNoLeakedGoroutines(t, func() {
+		out, err := chans.Collect(context.Background(), chans.Zip(context.Background(), producer(1, 1_000_000_000), chans.Of(chans.FromValues("a", "b", "c"))))
+		assert.NoError(t, err)
+		assert.Equal(t, []chans.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}, {First: 3, Second: "c"}}, out)
+	})
+}
+
+func TestZipWith(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+
+	assertNoLeakedGoroutines(t, func() {
+		out, err := chans.Collect(context.Background(), chans.ZipWith(context.Background(), producer(1, 5), producer(10, 12), sum))
+		assert.NoError(t, err)
+		assert.Equal(t, []int{11, 13, 15}, out)
+	})
+}
+
+func TestEnumerate(t *testing.T) {
+	out, err := chans.Collect(context.Background(), chans.Enumerate(context.Background(), chans.FromValues("a", "b", "c")))
+	assert.NoError(t, err)
+	assert.Equal(t, []chans.Pair[int, string]{{First: 0, Second: "a"}, {First: 1, Second: "b"}, {First: 2, Second: "c"}}, out)
+}
+
+func TestDistinct(t *testing.T) {
+	out, err := chans.Collect(context.Background(), chans.Distinct(context.Background(), chans.FromValues(0, 3, 2, 7, 2, 1, 3, 0)))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 3, 2, 7, 1}, out)
+}
+
+// producer returns a Producer of the integers in [from, to]
+func producer(from, to int) chans.Producer[int] {
+	return func(ctx context.Context) <-chan int { return counter(ctx, from, to) }
+}
+
+// assertNoLeakedGoroutines fails if f leaves goroutines running after it returns
+func assertNoLeakedGoroutines(t *testing.T, f func()) {
+	before := runtime.NumGoroutine()
+	f()
+
+	// polling manually, because assert.Eventually starts goroutines of its own
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			assert.Fail(t, "goroutines leaked", "%d goroutines before, %d after", before, runtime.NumGoroutine())
+			return
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package chans
+
+import (
+	"context"
+	"math/big"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/axelarnetwork/utils/internal/ranges"
+)
+
+// RangeStep creates a channel that contains all values from `from` to `to` with the given step size.
+// Counts down if `from` is greater than `to`. `to` is included if it is reached exactly.
+// Runs until the context expires or the range is exhausted. Panics if step is not positive.
+func RangeStep[T constraints.Integer](ctx context.Context, from, to, step T) <-chan T {
+	if step <= 0 {
+		panic("step must be positive")
+	}
+
+	newCh := make(chan T)
+
+	go func() {
+		defer close(newCh)
+
+		for i := from; Push(ctx, newCh, i); {
+			var ok bool
+			if i, ok = ranges.StepTowards(i, to, step); !ok {
+				return
+			}
+		}
+	}()
+
+	return newCh
+}
+
+// RangeBigStep creates a channel that contains all values from `from` to `to` with the given step size.
+// Counts down if `from` is greater than `to`. `to` is included if it is reached exactly.
+// Runs until the context expires or the range is exhausted. Panics if step is not positive.
+func RangeBigStep(ctx context.Context, from, to, step *big.Int) <-chan *big.Int {
+	if step.Sign() <= 0 {
+		panic("step must be positive")
+	}
+
+	newCh := make(chan *big.Int)
+
+	go func() {
+		defer close(newCh)
+
+		descending := from.Cmp(to) > 0
+		for i := (&big.Int{}).Set(from); ; {
+			if (descending && i.Cmp(to) < 0) || (!descending && i.Cmp(to) > 0) {
+				return
+			}
+
+			if !Push(ctx, newCh, (&big.Int{}).Set(i)) {
+				return
+			}
+
+			if descending {
+				i.Sub(i, step)
+			} else {
+				i.Add(i, step)
+			}
+		}
+	}()
+
+	return newCh
+}
+
+// RangeChunks creates a channel of inclusive [from, to] sub-ranges that cover all values from `from` to `to`.
+// Each sub-range contains at most maxSize values, only the last one can be smaller.
+// Sub-ranges count down if `from` is greater than `to`, e.g. [10, 6], [5, 1], [0, 0].
+// Runs until the context expires or the range is exhausted. Panics if maxSize is not positive.
+func RangeChunks[T constraints.Integer](ctx context.Context, from, to, maxSize T) <-chan [2]T {
+	if maxSize <= 0 {
+		panic("chunk size must be positive")
+	}
+
+	newCh := make(chan [2]T)
+
+	go func() {
+		defer close(newCh)
+
+		for start := from; ; {
+			end, ok := ranges.StepTowards(start, to, maxSize-1)
+			if !ok {
+				end = to
+			}
+
+			if !Push(ctx, newCh, [2]T{start, end}) {
+				return
+			}
+
+			if start, ok = ranges.StepTowards(end, to, 1); !ok {
+				return
+			}
+		}
+	}()
+
+	return newCh
+}
+
+// RangeBigChunks creates a channel of inclusive [from, to] sub-ranges that cover all values from `from` to `to`.
+// Each sub-range contains at most maxSize values, only the last one can be smaller.
+// Sub-ranges count down if `from` is greater than `to`, e.g. [10, 6], [5, 1], [0, 0].
+// Runs until the context expires or the range is exhausted. Panics if maxSize is not positive.
+func RangeBigChunks(ctx context.Context, from, to, maxSize *big.Int) <-chan [2]*big.Int {
+	if maxSize.Sign() <= 0 {
+		panic("chunk size must be positive")
+	}
+
+	newCh := make(chan [2]*big.Int)
+
+	go func() {
+		defer close(newCh)
+
+		descending := from.Cmp(to) > 0
+		offset := (&big.Int{}).Sub(maxSize, oneBig)
+		if descending {
+			offset.Neg(offset)
+		}
+
+		for start := (&big.Int{}).Set(from); ; {
+			end := (&big.Int{}).Add(start, offset)
+			if (descending && end.Cmp(to) < 0) || (!descending && end.Cmp(to) > 0) {
+				end.Set(to)
+			}
+
+			if !Push(ctx, newCh, [2]*big.Int{start, end}) || end.Cmp(to) == 0 {
+				return
+			}
+
+			if descending {
+				start = (&big.Int{}).Sub(end, oneBig)
+			} else {
+				start = (&big.Int{}).Add(end, oneBig)
+			}
+		}
+	}()
+
+	return newCh
+}
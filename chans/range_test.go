@@ -0,0 +1,100 @@
+package chans_test
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/chans"
+	testutils "github.com/axelarnetwork/utils/test"
+)
+
+func TestRangeStep(t *testing.T) {
+	ctx := context.Background()
+
+	out, _ := chans.Collect(ctx, chans.RangeStep(ctx, 0, 10, 3))
+	assert.Equal(t, []int{0, 3, 6, 9}, out)
+
+	out, _ = chans.Collect(ctx, chans.RangeStep(ctx, 10, 0, 5))
+	assert.Equal(t, []int{10, 5, 0}, out)
+
+	out, _ = chans.Collect(ctx, chans.RangeStep(ctx, 4, 4, 5))
+	assert.Equal(t, []int{4}, out)
+
+	bytes, _ := chans.Collect(ctx, chans.RangeStep[uint8](ctx, 250, math.MaxUint8, 4))
+	assert.Equal(t, []uint8{250, 254}, bytes)
+
+	bytes, _ = chans.Collect(ctx, chans.RangeStep[uint8](ctx, 5, 0, 2))
+	assert.Equal(t, []uint8{5, 3, 1}, bytes)
+
+	assert.Panics(t, func() { chans.RangeStep(ctx, 0, 10, 0) })
+}
+
+func TestRangeStep_CancelledContext(t *testing.T) {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		values := chans.RangeStep(ctx, 0, math.MaxInt64, 1)
+		<-values
+		cancel()
+
+		chans.Drain(values)
+	}()
+
+	testutils.FailOnTimeout(t, done, 1*time.Second)
+}
+
+func TestRangeBigStep(t *testing.T) {
+	ctx := context.Background()
+
+	out, _ := chans.Collect(ctx, chans.RangeBigStep(ctx, big.NewInt(-3), big.NewInt(3), big.NewInt(2)))
+	assert.Equal(t, []*big.Int{big.NewInt(-3), big.NewInt(-1), big.NewInt(1), big.NewInt(3)}, out)
+
+	out, _ = chans.Collect(ctx, chans.RangeBigStep(ctx, big.NewInt(3), big.NewInt(-3), big.NewInt(4)))
+	assert.Equal(t, []*big.Int{big.NewInt(3), big.NewInt(-1)}, out)
+
+	assert.Panics(t, func() { chans.RangeBigStep(ctx, big.NewInt(0), big.NewInt(1), big.NewInt(-1)) })
+}
+
+func TestRangeChunks(t *testing.T) {
+	ctx := context.Background()
+
+	out, _ := chans.Collect(ctx, chans.RangeChunks(ctx, 1, 10, 4))
+	assert.Equal(t, [][2]int{{1, 4}, {5, 8}, {9, 10}}, out)
+
+	out, _ = chans.Collect(ctx, chans.RangeChunks(ctx, 10, 0, 5))
+	assert.Equal(t, [][2]int{{10, 6}, {5, 1}, {0, 0}}, out)
+
+	out, _ = chans.Collect(ctx, chans.RangeChunks(ctx, 1, 3, 1))
+	assert.Equal(t, [][2]int{{1, 1}, {2, 2}, {3, 3}}, out)
+
+	bytes, _ := chans.Collect(ctx, chans.RangeChunks[uint8](ctx, 200, math.MaxUint8, 100))
+	assert.Equal(t, [][2]uint8{{200, math.MaxUint8}}, bytes)
+
+	assert.Panics(t, func() { chans.RangeChunks(ctx, 0, 10, 0) })
+}
+
+func TestRangeBigChunks(t *testing.T) {
+	ctx := context.Background()
+
+	out, _ := chans.Collect(ctx, chans.RangeBigChunks(ctx, big.NewInt(1), big.NewInt(10), big.NewInt(4)))
+	assert.Equal(t, [][2]*big.Int{
+		{big.NewInt(1), big.NewInt(4)},
+		{big.NewInt(5), big.NewInt(8)},
+		{big.NewInt(9), big.NewInt(10)},
+	}, out)
+
+	out, _ = chans.Collect(ctx, chans.RangeBigChunks(ctx, big.NewInt(11), big.NewInt(1), big.NewInt(5)))
+	assert.Equal(t, [][2]*big.Int{
+		{big.NewInt(11), big.NewInt(7)},
+		{big.NewInt(6), big.NewInt(2)},
+		{big.NewInt(1), big.NewInt(1)},
+	}, out)
+}
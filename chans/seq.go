@@ -0,0 +1,30 @@
+package chans
+
+import (
+	"context"
+	"iter"
+)
+
+// Seq returns a lazy sequence of the elements received from the channel. The sequence ends when the channel is closed or the context expires
+func Seq[T any](ctx context.Context, source <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		_ = consume(ctx, source, yield)
+	}
+}
+
+// FromSeq creates a channel that contains all elements of the sequence. Runs until the context expires or the sequence ends
+func FromSeq[T any](ctx context.Context, seq iter.Seq[T]) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		for x := range seq {
+			if !Push(ctx, out, x) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,32 @@
+package chans_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/chans"
+)
+
+func TestSeq(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(chans.Seq(context.Background(), chans.Range(1, 3))))
+	assert.Empty(t, slices.Collect(chans.Seq(cancelled(), chans.Range(1, 3))))
+
+	source := chans.FromValues(1, 2, 3)
+	for x := range chans.Seq(context.Background(), source) {
+		if x == 2 {
+			break
+		}
+	}
+	assert.Len(t, source, 1)
+}
+
+func TestFromSeq(t *testing.T) {
+	out, err := chans.Collect(context.Background(), chans.FromSeq(context.Background(), slices.Values([]int{1, 2, 3})))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, out)
+
+	assert.Equal(t, 0, chans.Drain(chans.FromSeq(cancelled(), slices.Values([]int{1, 2, 3}))))
+}
@@ -0,0 +1,23 @@
+// Package ranges contains the stepping logic shared by the range helpers of the chans and slices packages.
+package ranges
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// StepTowards moves i by step towards `to`. Returns false if the result would pass `to` or overflow.
+func StepTowards[T constraints.Integer](i, to, step T) (T, bool) {
+	if i <= to {
+		next := i + step
+		if next < i || next > to {
+			return i, false
+		}
+		return next, true
+	}
+
+	next := i - step
+	if next > i || next < to {
+		return i, false
+	}
+	return next, true
+}
@@ -0,0 +1,32 @@
+package ranges_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/internal/ranges"
+)
+
+func TestStepTowards(t *testing.T) {
+	next, ok := ranges.StepTowards(1, 10, 3)
+	assert.True(t, ok)
+	assert.Equal(t, 4, next)
+
+	next, ok = ranges.StepTowards(10, 1, 3)
+	assert.True(t, ok)
+	assert.Equal(t, 7, next)
+
+	_, ok = ranges.StepTowards(9, 10, 3)
+	assert.False(t, ok)
+
+	_, ok = ranges.StepTowards(2, 1, 3)
+	assert.False(t, ok)
+
+	_, ok = ranges.StepTowards[uint8](250, math.MaxUint8, 10)
+	assert.False(t, ok)
+
+	_, ok = ranges.StepTowards[int8](-120, math.MinInt8, 10)
+	assert.False(t, ok)
+}
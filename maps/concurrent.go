@@ -0,0 +1,235 @@
+package maps
+
+import (
+	"hash/maphash"
+	"sync"
+)
+
+const defaultShardCount = 32
+
+// ConcurrentMap is a typed map that is safe for concurrent use. Keys are distributed over independently locked shards to reduce contention.
+// The zero value is an empty map with the default number of shards. A ConcurrentMap must not be copied after first use
+type ConcurrentMap[K comparable, V any] struct {
+	// init guards the lazy creation of the shards, so the zero value is ready to use
+	init   sync.Once
+	seed   maphash.Seed
+	shards []*shard[K, V]
+}
+
+type shard[K comparable, V any] struct {
+	mu      sync.RWMutex
+	values  map[K]V
+	pending map[K]*computation[V]
+}
+
+// computation tracks a running LoadOrCompute constructor so concurrent callers for the same key can wait for its result
+type computation[V any] struct {
+	done  chan struct{}
+	value V
+	ok    bool
+}
+
+// NewConcurrentMap returns a new empty ConcurrentMap. The number of shards can be specified via an optional argument, default is 32
+func NewConcurrentMap[K comparable, V any](shardCount ...int) *ConcurrentMap[K, V] {
+	n := defaultShardCount
+	if len(shardCount) > 0 && shardCount[0] > 0 {
+		n = shardCount[0]
+	}
+
+	m := &ConcurrentMap[K, V]{}
+	m.init.Do(func() { m.makeShards(n) })
+
+	return m
+}
+
+// Load returns the value for the given key. Returns false if the key is not included in the map
+func (m *ConcurrentMap[K, V]) Load(key K) (V, bool) {
+	s := m.shard(key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	v, ok := s.values[key]
+	return v, ok
+}
+
+// Store sets the value for the given key
+func (m *ConcurrentMap[K, V]) Store(key K, value V) {
+	s := m.shard(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.values[key] = value
+}
+
+// Delete removes the given key from the map
+func (m *ConcurrentMap[K, V]) Delete(key K) {
+	s := m.shard(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.values, key)
+}
+
+// LoadOrStore returns the existing value for the given key if present. Otherwise, it stores and returns the given value.
+// The loaded result is true if the value was loaded, false if stored
+func (m *ConcurrentMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	s := m.shard(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.values[key]; ok {
+		return existing, true
+	}
+
+	s.values[key] = value
+	return value, false
+}
+
+// LoadOrCompute returns the existing value for the given key if present. Otherwise, it calls compute, stores and returns its result.
+// Concurrent calls for the same key wait for a single call of compute, other keys are not blocked while compute runs.
+// If a value is stored for the key while compute runs, that value takes precedence.
+// The loaded result is true if the value was not computed by this call
+func (m *ConcurrentMap[K, V]) LoadOrCompute(key K, compute func() V) (actual V, loaded bool) {
+	if v, ok := m.Load(key); ok {
+		return v, true
+	}
+
+	s := m.shard(key)
+
+	s.mu.Lock()
+	if v, ok := s.values[key]; ok {
+		s.mu.Unlock()
+		return v, true
+	}
+
+	if c, ok := s.pending[key]; ok {
+		s.mu.Unlock()
+
+		<-c.done
+		if c.ok {
+			return c.value, true
+		}
+
+		// the other computation panicked, so try again
+		return m.LoadOrCompute(key, compute)
+	}
+
+	c := &computation[V]{done: make(chan struct{})}
+	s.pending[key] = c
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.pending, key)
+		if c.ok {
+			if existing, ok := s.values[key]; ok {
+				c.value, loaded = existing, true
+			} else {
+				s.values[key] = c.value
+			}
+		}
+		s.mu.Unlock()
+
+		close(c.done)
+		actual = c.value
+	}()
+
+	c.value = compute()
+	c.ok = true
+
+	return c.value, false
+}
+
+// CompareAndSwap stores the new value for the given key if the current value is equal to old. Returns true if the value was swapped.
+// Panics if V is not a comparable type
+func (m *ConcurrentMap[K, V]) CompareAndSwap(key K, old, new V) bool {
+	s := m.shard(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if current, ok := s.values[key]; !ok || any(current) != any(old) {
+		return false
+	}
+
+	s.values[key] = new
+	return true
+}
+
+// CompareAndDelete removes the given key if its value is equal to old. Returns true if the key was deleted.
+// Panics if V is not a comparable type
+func (m *ConcurrentMap[K, V]) CompareAndDelete(key K, old V) bool {
+	s := m.shard(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if current, ok := s.values[key]; !ok || any(current) != any(old) {
+		return false
+	}
+
+	delete(s.values, key)
+	return true
+}
+
+// Len returns the number of entries in the map
+func (m *ConcurrentMap[K, V]) Len() int {
+	n := 0
+	for _, s := range m.allShards() {
+		s.mu.RLock()
+		n += len(s.values)
+		s.mu.RUnlock()
+	}
+
+	return n
+}
+
+// Snapshot returns a copy of all entries. Each shard is copied atomically, but concurrent writes to different shards may or may not be included
+func (m *ConcurrentMap[K, V]) Snapshot() map[K]V {
+	out := make(map[K]V)
+	for _, s := range m.allShards() {
+		s.mu.RLock()
+		for k, v := range s.values {
+			out[k] = v
+		}
+		s.mu.RUnlock()
+	}
+
+	return out
+}
+
+// Range calls f for each entry of a snapshot of the map until f returns false. No locks are held while f runs,
+// so it is safe to modify the map from within f
+func (m *ConcurrentMap[K, V]) Range(f func(key K, value V) bool) {
+	for k, v := range m.Snapshot() {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+func (m *ConcurrentMap[K, V]) shard(key K) *shard[K, V] {
+	shards := m.allShards()
+	return shards[maphash.Comparable(m.seed, key)%uint64(len(shards))]
+}
+
+// allShards returns the shards of the map, creating the default number of shards on first use of a zero value
+func (m *ConcurrentMap[K, V]) allShards() []*shard[K, V] {
+	m.init.Do(func() { m.makeShards(defaultShardCount) })
+	return m.shards
+}
+
+func (m *ConcurrentMap[K, V]) makeShards(n int) {
+	m.seed = maphash.MakeSeed()
+	m.shards = make([]*shard[K, V], n)
+	for i := range m.shards {
+		m.shards[i] = &shard[K, V]{
+			values:  make(map[K]V),
+			pending: make(map[K]*computation[V]),
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package maps_test
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/maps"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	m := maps.NewConcurrentMap[string, int]()
+
+	m.Store("a", 1)
+	assert.Equal(t, 1, funcs.MustOk(m.Load("a")))
+
+	actual, loaded := m.LoadOrStore("a", 2)
+	assert.True(t, loaded)
+	assert.Equal(t, 1, actual)
+
+	actual, loaded = m.LoadOrStore("b", 2)
+	assert.False(t, loaded)
+	assert.Equal(t, 2, actual)
+
+	assert.False(t, m.CompareAndSwap("a", 5, 10))
+	assert.True(t, m.CompareAndSwap("a", 1, 10))
+	assert.False(t, m.CompareAndSwap("c", 0, 10))
+	assert.Equal(t, 10, funcs.MustOk(m.Load("a")))
+
+	assert.False(t, m.CompareAndDelete("a", 1))
+	assert.True(t, m.CompareAndDelete("a", 10))
+
+	m.Delete("b")
+	_, ok := m.Load("b")
+	assert.False(t, ok)
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestConcurrentMap_ZeroValue(t *testing.T) {
+	var empty maps.ConcurrentMap[string, int]
+	assert.Equal(t, 0, empty.Len())
+	assert.Empty(t, empty.Snapshot())
+
+	var m maps.ConcurrentMap[string, int]
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Store(strconv.Itoa(i), i)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 10, m.Len())
+	assert.Equal(t, 3, funcs.MustOk(m.Load("3")))
+}
+
+func TestConcurrentMap_LoadOrCompute(t *testing.T) {
+	m := maps.NewConcurrentMap[string, int](4)
+
+	var calls atomic.Int64
+	compute := func() int {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return 42
+	}
+
+	wg := sync.WaitGroup{}
+	var computed atomic.Int64
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			v, loaded := m.LoadOrCompute("key", compute)
+			assert.Equal(t, 42, v)
+			if !loaded {
+				computed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 1, calls.Load())
+	assert.EqualValues(t, 1, computed.Load())
+}
+
+func TestConcurrentMap_LoadOrCompute_Panic(t *testing.T) {
+	m := maps.NewConcurrentMap[string, int]()
+
+	assert.Panics(t, func() { m.LoadOrCompute("key", func() int { panic("failed") }) })
+
+	v, loaded := m.LoadOrCompute("key", func() int { return 1 })
+	assert.False(t, loaded)
+	assert.Equal(t, 1, v)
+}
+
+func TestConcurrentMap_Range(t *testing.T) {
+	m := maps.NewConcurrentMap[int, string]()
+	for i := 0; i < 100; i++ {
+		m.Store(i, strconv.Itoa(i))
+	}
+
+	assert.Equal(t, 100, m.Len())
+	assert.Len(t, m.Snapshot(), 100)
+
+	// modifying the map during iteration does not deadlock
+	visited := 0
+	m.Range(func(k int, v string) bool {
+		assert.Equal(t, strconv.Itoa(k), v)
+		m.Delete(k)
+		visited++
+		return visited < 50
+	})
+
+	assert.Equal(t, 50, visited)
+	assert.Equal(t, 50, m.Len())
+}
+
+func TestConcurrentMap_Concurrency(t *testing.T) {
+	m := maps.NewConcurrentMap[int, int]()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				m.Store(j, j)
+				m.Load(j)
+				m.CompareAndSwap(j, j, j+1)
+				m.LoadOrCompute(j+1000, func() int { return j })
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2000, m.Len())
+}
+
+const benchmarkKeys = 1000
+
+func BenchmarkConcurrentMap_ReadHeavy(b *testing.B) {
+	benchmarkMaps(b, 9)
+}
+
+func BenchmarkConcurrentMap_WriteHeavy(b *testing.B) {
+	benchmarkMaps(b, 1)
+}
+
+// benchmarkMaps compares ConcurrentMap and sync.Map with the given number of reads per write
+func benchmarkMaps(b *testing.B, readsPerWrite int) {
+	b.Run("ConcurrentMap", func(b *testing.B) {
+		m := maps.NewConcurrentMap[int, int]()
+		for i := 0; i < benchmarkKeys; i++ {
+			m.Store(i, i)
+		}
+
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				if i%(readsPerWrite+1) == 0 {
+					m.Store(i%benchmarkKeys, i)
+				} else {
+					m.Load(i % benchmarkKeys)
+				}
+				i++
+			}
+		})
+	})
+
+	b.Run("sync.Map", func(b *testing.B) {
+		m := sync.Map{}
+		for i := 0; i < benchmarkKeys; i++ {
+			m.Store(i, i)
+		}
+
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				if i%(readsPerWrite+1) == 0 {
+					m.Store(i%benchmarkKeys, i)
+				} else if v, ok := m.Load(i % benchmarkKeys); ok {
+					_ = v.(int)
+				}
+				i++
+			}
+		})
+	})
+}
@@ -0,0 +1,218 @@
+package maps
+
+import "fmt"
+
+// MultiMap associates each key with a list of values. The zero value is an empty map ready to use, which allows duplicate values.
+// It is not safe for concurrent use
+type MultiMap[K, V comparable] struct {
+	values   map[K][]V
+	distinct bool
+	length   int
+}
+
+// NewMultiMap returns a new empty MultiMap. If distinctValues is set, the same value is stored at most once per key
+func NewMultiMap[K, V comparable](distinctValues ...bool) *MultiMap[K, V] {
+	return &MultiMap[K, V]{
+		values:   make(map[K][]V),
+		distinct: len(distinctValues) > 0 && distinctValues[0],
+	}
+}
+
+// Add appends the value to the given key. Returns false if the map only holds distinct values and the value is already associated with the key
+func (m *MultiMap[K, V]) Add(key K, value V) bool {
+	if m.distinct && m.Contains(key, value) {
+		return false
+	}
+
+	if m.values == nil {
+		m.values = make(map[K][]V)
+	}
+
+	m.values[key] = append(m.values[key], value)
+	m.length++
+
+	return true
+}
+
+// Remove deletes the first occurrence of the value from the given key. Returns false if the value is not associated with the key
+func (m *MultiMap[K, V]) Remove(key K, value V) bool {
+	values := m.values[key]
+	for i := range values {
+		if values[i] != value {
+			continue
+		}
+
+		if len(values) == 1 {
+			delete(m.values, key)
+		} else {
+			m.values[key] = append(values[:i:i], values[i+1:]...)
+		}
+
+		m.length--
+		return true
+	}
+
+	return false
+}
+
+// RemoveAll deletes the given key and all of its values. Returns the number of removed values
+func (m *MultiMap[K, V]) RemoveAll(key K) int {
+	n := len(m.values[key])
+	delete(m.values, key)
+	m.length -= n
+
+	return n
+}
+
+// Get returns a copy of the values associated with the given key in insertion order
+func (m *MultiMap[K, V]) Get(key K) []V {
+	return append([]V{}, m.values[key]...)
+}
+
+// Has returns true if at least one value is associated with the given key
+func (m *MultiMap[K, V]) Has(key K) bool {
+	_, ok := m.values[key]
+	return ok
+}
+
+// Contains returns true if the value is associated with the given key
+func (m *MultiMap[K, V]) Contains(key K, value V) bool {
+	for _, v := range m.values[key] {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Count returns the number of values associated with the given key
+func (m *MultiMap[K, V]) Count(key K) int {
+	return len(m.values[key])
+}
+
+// Len returns the total number of values across all keys
+func (m *MultiMap[K, V]) Len() int {
+	return m.length
+}
+
+// Keys returns all keys in unspecified order
+func (m *MultiMap[K, V]) Keys() []K {
+	return Keys(m.values)
+}
+
+// ToMap returns a copy of the underlying map
+func (m *MultiMap[K, V]) ToMap() map[K][]V {
+	return CloneWith(m.values, func(values []V) []V { return append([]V{}, values...) })
+}
+
+// BiMap is a bidirectional map that enforces uniqueness of both keys and values. The zero value is an empty map ready to use.
+// It is not safe for concurrent use
+type BiMap[K, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+}
+
+// NewBiMap returns a new empty BiMap
+func NewBiMap[K, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward:  make(map[K]V),
+		backward: make(map[V]K),
+	}
+}
+
+// Put associates the key with the value. Returns an error if the key or the value is already part of a different pair
+func (m *BiMap[K, V]) Put(key K, value V) error {
+	if existing, ok := m.forward[key]; ok && existing != value {
+		return fmt.Errorf("key %v is already mapped to %v", key, existing)
+	}
+
+	if existing, ok := m.backward[value]; ok && existing != key {
+		return fmt.Errorf("value %v is already mapped to %v", value, existing)
+	}
+
+	m.set(key, value)
+
+	return nil
+}
+
+// ForcePut associates the key with the value, removing any existing pairs that contain the key or the value
+func (m *BiMap[K, V]) ForcePut(key K, value V) {
+	m.DeleteByKey(key)
+	m.DeleteByValue(value)
+
+	m.set(key, value)
+}
+
+// GetByKey returns the value for the given key. Returns false if the key is not included in the map
+func (m *BiMap[K, V]) GetByKey(key K) (V, bool) {
+	v, ok := m.forward[key]
+	return v, ok
+}
+
+// GetByValue returns the key for the given value. Returns false if the value is not included in the map
+func (m *BiMap[K, V]) GetByValue(value V) (K, bool) {
+	k, ok := m.backward[value]
+	return k, ok
+}
+
+// DeleteByKey removes the pair with the given key. Returns false if the key is not included in the map
+func (m *BiMap[K, V]) DeleteByKey(key K) bool {
+	v, ok := m.forward[key]
+	if !ok {
+		return false
+	}
+
+	delete(m.forward, key)
+	delete(m.backward, v)
+
+	return true
+}
+
+// DeleteByValue removes the pair with the given value. Returns false if the value is not included in the map
+func (m *BiMap[K, V]) DeleteByValue(value V) bool {
+	k, ok := m.backward[value]
+	if !ok {
+		return false
+	}
+
+	delete(m.forward, k)
+	delete(m.backward, value)
+
+	return true
+}
+
+// Len returns the number of pairs in the map
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// Inverse returns a view of the map with keys and values swapped. Changes to the view are reflected in the original map and vice versa
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	// the maps must exist before they are shared, otherwise a view of the zero value would allocate maps of its own
+	m.init()
+
+	return &BiMap[V, K]{
+		forward:  m.backward,
+		backward: m.forward,
+	}
+}
+
+// ToMap returns a copy of the key to value mapping
+func (m *BiMap[K, V]) ToMap() map[K]V {
+	return Clone(m.forward)
+}
+
+func (m *BiMap[K, V]) set(key K, value V) {
+	m.init()
+
+	m.forward[key] = value
+	m.backward[value] = key
+}
+
+func (m *BiMap[K, V]) init() {
+	if m.forward == nil {
+		m.forward = make(map[K]V)
+		m.backward = make(map[V]K)
+	}
+}
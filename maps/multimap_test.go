@@ -0,0 +1,119 @@
+package maps_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/maps"
+)
+
+func TestMultiMap(t *testing.T) {
+	m := maps.NewMultiMap[string, int]()
+
+	assert.True(t, m.Add("a", 1))
+	assert.True(t, m.Add("a", 2))
+	assert.True(t, m.Add("a", 1))
+	assert.True(t, m.Add("b", 3))
+
+	assert.Equal(t, []int{1, 2, 1}, m.Get("a"))
+	assert.Equal(t, 3, m.Count("a"))
+	assert.Equal(t, 4, m.Len())
+	assert.ElementsMatch(t, []string{"a", "b"}, m.Keys())
+	assert.True(t, m.Contains("a", 2))
+	assert.False(t, m.Contains("b", 2))
+
+	assert.True(t, m.Remove("a", 1))
+	assert.Equal(t, []int{2, 1}, m.Get("a"))
+	assert.False(t, m.Remove("a", 5))
+
+	assert.True(t, m.Remove("b", 3))
+	assert.False(t, m.Has("b"))
+	assert.Empty(t, m.Get("b"))
+
+	assert.Equal(t, 2, m.RemoveAll("a"))
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestMultiMap_Distinct(t *testing.T) {
+	m := maps.NewMultiMap[string, int](true)
+
+	assert.True(t, m.Add("a", 1))
+	assert.False(t, m.Add("a", 1))
+	assert.True(t, m.Add("b", 1))
+	assert.Equal(t, map[string][]int{"a": {1}, "b": {1}}, m.ToMap())
+}
+
+func TestMultiMap_ZeroValue(t *testing.T) {
+	var m maps.MultiMap[string, int]
+	assert.Empty(t, m.Get("a"))
+	assert.False(t, m.Remove("a", 1))
+
+	assert.True(t, m.Add("a", 1))
+	assert.True(t, m.Add("a", 1))
+	assert.Equal(t, []int{1, 1}, m.Get("a"))
+	assert.Equal(t, 2, m.Len())
+}
+
+func TestMultiMap_GetReturnsCopy(t *testing.T) {
+	m := maps.NewMultiMap[string, int]()
+	m.Add("a", 1)
+
+	m.Get("a")[0] = 10
+	m.ToMap()["a"][0] = 10
+	assert.Equal(t, []int{1}, m.Get("a"))
+}
+
+func TestBiMap(t *testing.T) {
+	m := maps.NewBiMap[string, int]()
+
+	assert.NoError(t, m.Put("ethereum", 1))
+	assert.NoError(t, m.Put("polygon", 137))
+	assert.NoError(t, m.Put("ethereum", 1))
+	assert.Error(t, m.Put("ethereum", 5))
+	assert.Error(t, m.Put("mainnet", 1))
+
+	assert.Equal(t, 137, funcs.MustOk(m.GetByKey("polygon")))
+	assert.Equal(t, "ethereum", funcs.MustOk(m.GetByValue(1)))
+	assert.Equal(t, 2, m.Len())
+
+	m.ForcePut("mainnet", 1)
+	_, ok := m.GetByKey("ethereum")
+	assert.False(t, ok)
+	assert.Equal(t, "mainnet", funcs.MustOk(m.GetByValue(1)))
+
+	assert.True(t, m.DeleteByValue(137))
+	assert.False(t, m.DeleteByKey("polygon"))
+	assert.Equal(t, map[string]int{"mainnet": 1}, m.ToMap())
+}
+
+func TestBiMap_ZeroValue(t *testing.T) {
+	var m maps.BiMap[string, int]
+	assert.False(t, m.DeleteByKey("a"))
+
+	assert.NoError(t, m.Put("a", 1))
+	assert.Error(t, m.Put("b", 1))
+	assert.Equal(t, "a", funcs.MustOk(m.GetByValue(1)))
+
+	var forced maps.BiMap[string, int]
+	forced.ForcePut("a", 1)
+	assert.Equal(t, 1, funcs.MustOk(forced.GetByKey("a")))
+
+	// the view shares the maps with the original even if neither has been written to before
+	var empty maps.BiMap[string, int]
+	inverse := empty.Inverse()
+	assert.NoError(t, inverse.Put(1, "a"))
+	assert.Equal(t, 1, funcs.MustOk(empty.GetByKey("a")))
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	m := maps.NewBiMap[string, int]()
+	assert.NoError(t, m.Put("ethereum", 1))
+
+	inverse := m.Inverse()
+	assert.Equal(t, "ethereum", funcs.MustOk(inverse.GetByKey(1)))
+
+	assert.NoError(t, inverse.Put(137, "polygon"))
+	assert.Equal(t, 137, funcs.MustOk(m.GetByKey("polygon")))
+}
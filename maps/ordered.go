@@ -0,0 +1,143 @@
+package maps
+
+import (
+	"encoding/json"
+	"iter"
+)
+
+// OrderedMap is a map that iterates over its entries in insertion order. The zero value is an empty map ready to use.
+// It is not safe for concurrent use
+type OrderedMap[K comparable, V any] struct {
+	nodes map[K]*orderedNode[K, V]
+	// sentinel node of a circular doubly-linked list, sentinel.next is the oldest entry
+	sentinel *orderedNode[K, V]
+}
+
+type orderedNode[K comparable, V any] struct {
+	entry      Entry[K, V]
+	prev, next *orderedNode[K, V]
+}
+
+// NewOrderedMap returns a new empty OrderedMap
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{}
+}
+
+// Set inserts or updates the value for the given key. Updating an existing key keeps its position
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if m.sentinel == nil {
+		m.sentinel = &orderedNode[K, V]{}
+		m.sentinel.prev, m.sentinel.next = m.sentinel, m.sentinel
+		m.nodes = make(map[K]*orderedNode[K, V])
+	}
+
+	if node, ok := m.nodes[key]; ok {
+		node.entry.Value = value
+		return
+	}
+
+	node := &orderedNode[K, V]{entry: Entry[K, V]{Key: key, Value: value}, prev: m.sentinel.prev, next: m.sentinel}
+	node.prev.next = node
+	m.sentinel.prev = node
+	m.nodes[key] = node
+}
+
+// Get returns the value for the given key. Returns false if the key is not included in the map
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	node, ok := m.nodes[key]
+	if !ok {
+		return *new(V), false
+	}
+
+	return node.entry.Value, true
+}
+
+// Has returns true if the given key is included in the map
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.nodes[key]
+	return ok
+}
+
+// Delete removes the given key from the map. Returns false if the key was not included in the map
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	node, ok := m.nodes[key]
+	if !ok {
+		return false
+	}
+
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	delete(m.nodes, key)
+
+	return true
+}
+
+// Len returns the number of entries in the map
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.nodes)
+}
+
+// All returns a sequence of all entries in insertion order
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m.sentinel == nil {
+			return
+		}
+
+		for node := m.sentinel.next; node != m.sentinel; node = node.next {
+			if !yield(node.entry.Key, node.entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns all keys in insertion order
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Values returns all values in insertion order of their keys
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+	for _, v := range m.All() {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// Entries returns all key-value pairs in insertion order
+func (m *OrderedMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.Len())
+	for k, v := range m.All() {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+
+	return entries
+}
+
+// MarshalJSON encodes the map as a JSON array of key-value pairs in insertion order
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Entries())
+}
+
+// UnmarshalJSON decodes a JSON array of key-value pairs into the map, replacing any existing entries
+func (m *OrderedMap[K, V]) UnmarshalJSON(bz []byte) error {
+	var entries []Entry[K, V]
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return err
+	}
+
+	*m = *NewOrderedMap[K, V]()
+	for _, entry := range entries {
+		m.Set(entry.Key, entry.Value)
+	}
+
+	return nil
+}
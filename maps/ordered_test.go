@@ -0,0 +1,77 @@
+package maps_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/maps"
+)
+
+func TestOrderedMap(t *testing.T) {
+	m := maps.NewOrderedMap[string, int]()
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("a", 10)
+
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, []string{"c", "a", "b"}, m.Keys())
+	assert.Equal(t, []int{3, 10, 2}, m.Values())
+	assert.Equal(t, 10, funcs.MustOk(m.Get("a")))
+	assert.True(t, m.Has("b"))
+
+	assert.True(t, m.Delete("a"))
+	assert.False(t, m.Delete("a"))
+	_, ok := m.Get("a")
+	assert.False(t, ok)
+
+	m.Set("a", 1)
+	assert.Equal(t, []maps.Entry[string, int]{{"c", 3}, {"b", 2}, {"a", 1}}, m.Entries())
+
+	var keys []string
+	for k := range m.All() {
+		keys = append(keys, k)
+		if k == "b" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"c", "b"}, keys)
+}
+
+func TestOrderedMap_ZeroValue(t *testing.T) {
+	var m maps.OrderedMap[string, int]
+
+	_, ok := m.Get("a")
+	assert.False(t, ok)
+	assert.False(t, m.Delete("a"))
+	assert.Empty(t, m.Entries())
+
+	m.Set("b", 2)
+	m.Set("a", 1)
+	assert.Equal(t, []string{"b", "a"}, m.Keys())
+}
+
+func TestOrderedMap_JSON(t *testing.T) {
+	m := maps.NewOrderedMap[string, int]()
+	m.Set("z", 1)
+	m.Set("a", 2)
+
+	bz, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"key":"z","value":1},{"key":"a","value":2}]`, string(bz))
+
+	// maps embedded by value are not addressable when the outer struct is encoded by value
+	type wrapper struct{ M maps.OrderedMap[string, int] }
+	wrapped, err := json.Marshal(wrapper{M: *m})
+	require.NoError(t, err)
+	assert.Equal(t, `{"M":[{"key":"z","value":1},{"key":"a","value":2}]}`, string(wrapped))
+
+	decoded := maps.NewOrderedMap[string, int]()
+	decoded.Set("old", 0)
+	require.NoError(t, json.Unmarshal(bz, decoded))
+	assert.Equal(t, m.Entries(), decoded.Entries())
+}
@@ -0,0 +1,30 @@
+package maps
+
+import (
+	"iter"
+	stdmaps "maps"
+)
+
+// Seq returns a lazy sequence of the key-value pairs of the map. The iteration order is not specified.
+// It is the same as the standard library's maps.All
+func Seq[T1 comparable, T2 any](m map[T1]T2) iter.Seq2[T1, T2] {
+	return stdmaps.All(m)
+}
+
+// KeySeq returns a lazy sequence of the keys of the map. The iteration order is not specified.
+// It is the same as the standard library's maps.Keys
+func KeySeq[T1 comparable, T2 any](m map[T1]T2) iter.Seq[T1] {
+	return stdmaps.Keys(m)
+}
+
+// ValueSeq returns a lazy sequence of the values of the map. The iteration order is not specified.
+// It is the same as the standard library's maps.Values
+func ValueSeq[T1 comparable, T2 any](m map[T1]T2) iter.Seq[T2] {
+	return stdmaps.Values(m)
+}
+
+// FromSeq collects all key-value pairs of the sequence into a new map. Later values override earlier ones for colliding keys.
+// It is the same as the standard library's maps.Collect
+func FromSeq[T1 comparable, T2 any](seq iter.Seq2[T1, T2]) map[T1]T2 {
+	return stdmaps.Collect(seq)
+}
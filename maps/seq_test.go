@@ -0,0 +1,30 @@
+package maps_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/maps"
+)
+
+func TestSeq(t *testing.T) {
+	m := map[int]string{1: "a", 2: "b", 3: "c"}
+
+	assert.Equal(t, m, maps.FromSeq(maps.Seq(m)))
+
+	var keys []int
+	for k := range maps.KeySeq(m) {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	assert.Equal(t, []int{1, 2, 3}, keys)
+
+	var values []string
+	for v := range maps.ValueSeq(m) {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	assert.Equal(t, []string{"a", "b", "c"}, values)
+}
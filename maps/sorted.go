@@ -0,0 +1,274 @@
+package maps
+
+import (
+	"encoding/json"
+	"iter"
+	"math/rand/v2"
+
+	"golang.org/x/exp/constraints"
+)
+
+const maxSkipListLevel = 32
+
+// SortedMap is a map that iterates over its entries in ascending key order. It is backed by a skip list,
+// so lookups, inserts and deletes take O(log n) expected time. The zero value is an empty map ready to use. It is not safe for concurrent use
+type SortedMap[K constraints.Ordered, V any] struct {
+	head   *skipNode[K, V]
+	level  int
+	length int
+}
+
+type skipNode[K constraints.Ordered, V any] struct {
+	entry Entry[K, V]
+	next  []*skipNode[K, V]
+}
+
+// NewSortedMap returns a new empty SortedMap
+func NewSortedMap[K constraints.Ordered, V any]() *SortedMap[K, V] {
+	return &SortedMap[K, V]{}
+}
+
+// Set inserts or updates the value for the given key
+func (m *SortedMap[K, V]) Set(key K, value V) {
+	if m.head == nil {
+		m.head = &skipNode[K, V]{next: make([]*skipNode[K, V], maxSkipListLevel)}
+		m.level = 1
+	}
+
+	preds := m.predecessors(key)
+
+	if node := preds[0].next[0]; node != nil && node.entry.Key == key {
+		node.entry.Value = value
+		return
+	}
+
+	level := randomLevel()
+	if level > m.level {
+		for i := m.level; i < level; i++ {
+			preds[i] = m.head
+		}
+		m.level = level
+	}
+
+	node := &skipNode[K, V]{entry: Entry[K, V]{Key: key, Value: value}, next: make([]*skipNode[K, V], level)}
+	for i := 0; i < level; i++ {
+		node.next[i] = preds[i].next[i]
+		preds[i].next[i] = node
+	}
+
+	m.length++
+}
+
+// Get returns the value for the given key. Returns false if the key is not included in the map
+func (m *SortedMap[K, V]) Get(key K) (V, bool) {
+	node := m.find(key)
+	if node == nil || node.entry.Key != key {
+		return *new(V), false
+	}
+
+	return node.entry.Value, true
+}
+
+// Has returns true if the given key is included in the map
+func (m *SortedMap[K, V]) Has(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Delete removes the given key from the map. Returns false if the key was not included in the map
+func (m *SortedMap[K, V]) Delete(key K) bool {
+	if m.head == nil {
+		return false
+	}
+
+	preds := m.predecessors(key)
+
+	node := preds[0].next[0]
+	if node == nil || node.entry.Key != key {
+		return false
+	}
+
+	for i := range node.next {
+		preds[i].next[i] = node.next[i]
+	}
+
+	for m.level > 1 && m.head.next[m.level-1] == nil {
+		m.level--
+	}
+
+	m.length--
+	return true
+}
+
+// Len returns the number of entries in the map
+func (m *SortedMap[K, V]) Len() int {
+	return m.length
+}
+
+// Min returns the entry with the smallest key. Returns false if the map is empty
+func (m *SortedMap[K, V]) Min() (Entry[K, V], bool) {
+	return entryOf(m.front())
+}
+
+// Max returns the entry with the largest key. Returns false if the map is empty
+func (m *SortedMap[K, V]) Max() (Entry[K, V], bool) {
+	node := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for node.next[i] != nil {
+			node = node.next[i]
+		}
+	}
+
+	if node == m.head {
+		return Entry[K, V]{}, false
+	}
+	return node.entry, true
+}
+
+// Floor returns the entry with the largest key less than or equal to the given key. Returns false if there is no such entry
+func (m *SortedMap[K, V]) Floor(key K) (Entry[K, V], bool) {
+	node := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && node.next[i].entry.Key <= key {
+			node = node.next[i]
+		}
+	}
+
+	if node == m.head {
+		return Entry[K, V]{}, false
+	}
+	return node.entry, true
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to the given key. Returns false if there is no such entry
+func (m *SortedMap[K, V]) Ceiling(key K) (Entry[K, V], bool) {
+	return entryOf(m.find(key))
+}
+
+// Range returns all entries with keys in the inclusive range [from, to] in ascending key order
+func (m *SortedMap[K, V]) Range(from, to K) []Entry[K, V] {
+	var entries []Entry[K, V]
+	for node := m.find(from); node != nil && node.entry.Key <= to; node = node.next[0] {
+		entries = append(entries, node.entry)
+	}
+
+	return entries
+}
+
+// All returns a sequence of all entries in ascending key order
+func (m *SortedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := m.front(); node != nil; node = node.next[0] {
+			if !yield(node.entry.Key, node.entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns all keys in ascending order
+func (m *SortedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Values returns all values in ascending order of their keys
+func (m *SortedMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+	for _, v := range m.All() {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// Entries returns all key-value pairs in ascending key order
+func (m *SortedMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.Len())
+	for node := m.front(); node != nil; node = node.next[0] {
+		entries = append(entries, node.entry)
+	}
+
+	return entries
+}
+
+// MarshalJSON encodes the map as a JSON array of key-value pairs in ascending key order
+func (m SortedMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Entries())
+}
+
+// UnmarshalJSON decodes a JSON array of key-value pairs into the map, replacing any existing entries
+func (m *SortedMap[K, V]) UnmarshalJSON(bz []byte) error {
+	var entries []Entry[K, V]
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return err
+	}
+
+	*m = *NewSortedMap[K, V]()
+	for _, entry := range entries {
+		m.Set(entry.Key, entry.Value)
+	}
+
+	return nil
+}
+
+// front returns the node with the smallest key, or nil if the map is empty
+func (m *SortedMap[K, V]) front() *skipNode[K, V] {
+	if m.head == nil {
+		return nil
+	}
+	return m.head.next[0]
+}
+
+// find returns the node with the smallest key greater than or equal to the given key, or nil if there is no such node.
+// In contrast to predecessors, it does not allocate, so it is used for all read-only lookups
+func (m *SortedMap[K, V]) find(key K) *skipNode[K, V] {
+	if m.head == nil {
+		return nil
+	}
+
+	node := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && node.next[i].entry.Key < key {
+			node = node.next[i]
+		}
+	}
+
+	return node.next[0]
+}
+
+// predecessors returns the last node with a key smaller than the given key on each level
+func (m *SortedMap[K, V]) predecessors(key K) []*skipNode[K, V] {
+	preds := make([]*skipNode[K, V], maxSkipListLevel)
+
+	node := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && node.next[i].entry.Key < key {
+			node = node.next[i]
+		}
+		preds[i] = node
+	}
+
+	return preds
+}
+
+func entryOf[K constraints.Ordered, V any](node *skipNode[K, V]) (Entry[K, V], bool) {
+	if node == nil {
+		return Entry[K, V]{}, false
+	}
+	return node.entry, true
+}
+
+// randomLevel returns a level between 1 and maxSkipListLevel, where each additional level has a probability of 1/2
+func randomLevel() int {
+	level := 1
+	for level < maxSkipListLevel && rand.IntN(2) == 0 {
+		level++
+	}
+
+	return level
+}
@@ -0,0 +1,148 @@
+package maps_test
+
+import (
+	"encoding/json"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/maps"
+)
+
+func TestSortedMap(t *testing.T) {
+	m := maps.NewSortedMap[int, string]()
+	for _, k := range []int{50, 10, 30, 20, 40} {
+		m.Set(k, "v")
+	}
+	m.Set(30, "updated")
+
+	assert.Equal(t, 5, m.Len())
+	assert.Equal(t, []int{10, 20, 30, 40, 50}, m.Keys())
+	assert.Equal(t, "updated", funcs.MustOk(m.Get(30)))
+	assert.False(t, m.Has(35))
+
+	assert.Equal(t, 10, funcs.MustOk(m.Min()).Key)
+	assert.Equal(t, 50, funcs.MustOk(m.Max()).Key)
+
+	assert.Equal(t, 30, funcs.MustOk(m.Floor(35)).Key)
+	assert.Equal(t, 30, funcs.MustOk(m.Floor(30)).Key)
+	_, ok := m.Floor(5)
+	assert.False(t, ok)
+
+	assert.Equal(t, 40, funcs.MustOk(m.Ceiling(35)).Key)
+	assert.Equal(t, 40, funcs.MustOk(m.Ceiling(40)).Key)
+	_, ok = m.Ceiling(55)
+	assert.False(t, ok)
+
+	assert.Equal(t, []int{20, 30, 40}, keysOf(m.Range(15, 40)))
+	assert.Empty(t, m.Range(41, 49))
+
+	assert.True(t, m.Delete(30))
+	assert.False(t, m.Delete(30))
+	assert.Equal(t, []int{10, 20, 40, 50}, m.Keys())
+}
+
+func TestSortedMap_Empty(t *testing.T) {
+	m := maps.NewSortedMap[string, int]()
+
+	_, ok := m.Min()
+	assert.False(t, ok)
+	_, ok = m.Max()
+	assert.False(t, ok)
+	_, ok = m.Get("a")
+	assert.False(t, ok)
+	assert.Empty(t, m.Entries())
+}
+
+func TestSortedMap_ZeroValue(t *testing.T) {
+	var m maps.SortedMap[string, int]
+
+	_, ok := m.Min()
+	assert.False(t, ok)
+	_, ok = m.Max()
+	assert.False(t, ok)
+	_, ok = m.Floor("a")
+	assert.False(t, ok)
+	_, ok = m.Ceiling("a")
+	assert.False(t, ok)
+	assert.False(t, m.Has("a"))
+	assert.False(t, m.Delete("a"))
+	assert.Empty(t, m.Range("a", "z"))
+	assert.Empty(t, m.Keys())
+
+	m.Set("b", 2)
+	m.Set("a", 1)
+	assert.Equal(t, []string{"a", "b"}, m.Keys())
+	assert.Equal(t, 1, funcs.MustOk(m.Get("a")))
+}
+
+func TestSortedMap_LookupsDoNotAllocate(t *testing.T) {
+	m := maps.NewSortedMap[int, int]()
+	for i := 0; i < 1000; i++ {
+		m.Set(i, i)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		m.Get(500)
+		m.Has(1001)
+		m.Ceiling(250)
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestSortedMap_Random(t *testing.T) {
+	m := maps.NewSortedMap[int, int]()
+	reference := make(map[int]int)
+
+	for i := 0; i < 10000; i++ {
+		k := rand.IntN(1000)
+		if rand.IntN(3) == 0 {
+			assert.Equal(t, maps.Has(reference, k), m.Delete(k))
+			delete(reference, k)
+		} else {
+			m.Set(k, i)
+			reference[k] = i
+		}
+	}
+
+	keys := maps.Keys(reference)
+	sort.Ints(keys)
+	assert.Equal(t, keys, m.Keys())
+	assert.Equal(t, len(reference), m.Len())
+
+	for k, v := range reference {
+		assert.Equal(t, v, funcs.MustOk(m.Get(k)))
+	}
+}
+
+func TestSortedMap_JSON(t *testing.T) {
+	m := maps.NewSortedMap[string, int]()
+	m.Set("z", 1)
+	m.Set("a", 2)
+
+	bz, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"key":"a","value":2},{"key":"z","value":1}]`, string(bz))
+
+	// maps embedded by value are not addressable when the outer struct is encoded by value
+	type wrapper struct{ M maps.SortedMap[string, int] }
+	wrapped, err := json.Marshal(wrapper{M: *m})
+	require.NoError(t, err)
+	assert.Equal(t, `{"M":[{"key":"a","value":2},{"key":"z","value":1}]}`, string(wrapped))
+
+	decoded := maps.NewSortedMap[string, int]()
+	require.NoError(t, json.Unmarshal(bz, decoded))
+	assert.Equal(t, m.Entries(), decoded.Entries())
+}
+
+func keysOf[K comparable, V any](entries []maps.Entry[K, V]) []K {
+	keys := make([]K, len(entries))
+	for i := range entries {
+		keys[i] = entries[i].Key
+	}
+	return keys
+}
@@ -0,0 +1,208 @@
+package maps
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Entry is a single key-value pair of a map
+type Entry[T1 comparable, T2 any] struct {
+	Key   T1 `json:"key"`
+	Value T2 `json:"value"`
+}
+
+// Keys returns the keys of the map in unspecified order. Use SortedKeys for a deterministic order
+func Keys[T1 comparable, T2 any](m map[T1]T2) []T1 {
+	keys := make([]T1, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// SortedKeys returns the keys of the map in ascending order
+func SortedKeys[T1 constraints.Ordered, T2 any](m map[T1]T2) []T1 {
+	keys := Keys(m)
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	return keys
+}
+
+// Values returns the values of the map in unspecified order. Use ValuesSortedByKey for a deterministic order
+func Values[T1 comparable, T2 any](m map[T1]T2) []T2 {
+	values := make([]T2, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// ValuesSortedByKey returns the values of the map in ascending order of their keys
+func ValuesSortedByKey[T1 constraints.Ordered, T2 any](m map[T1]T2) []T2 {
+	keys := SortedKeys(m)
+
+	values := make([]T2, len(keys))
+	for i, k := range keys {
+		values[i] = m[k]
+	}
+
+	return values
+}
+
+// Entries returns the key-value pairs of the map in unspecified order. Use SortedEntries for a deterministic order
+func Entries[T1 comparable, T2 any](m map[T1]T2) []Entry[T1, T2] {
+	entries := make([]Entry[T1, T2], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, Entry[T1, T2]{Key: k, Value: v})
+	}
+
+	return entries
+}
+
+// SortedEntries returns the key-value pairs of the map in ascending order of their keys
+func SortedEntries[T1 constraints.Ordered, T2 any](m map[T1]T2) []Entry[T1, T2] {
+	entries := Entries(m)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+
+	return entries
+}
+
+// FromEntries returns a new map from the given key-value pairs. Later entries override earlier ones for colliding keys
+func FromEntries[T1 comparable, T2 any](entries []Entry[T1, T2]) map[T1]T2 {
+	m := make(map[T1]T2, len(entries))
+	for _, entry := range entries {
+		m[entry.Key] = entry.Value
+	}
+
+	return m
+}
+
+// MapValues returns a new map with the same keys and values transformed by the given function
+func MapValues[T1 comparable, T2, T3 any](m map[T1]T2, f func(T2) T3) map[T1]T3 {
+	out := make(map[T1]T3, len(m))
+	for k, v := range m {
+		out[k] = f(v)
+	}
+
+	return out
+}
+
+// MapKeys returns a new map with the same values and keys transformed by the given function.
+// If multiple keys map to the same new key, their values are combined with the resolve function.
+// The keys are processed in ascending order, so resolve is called with the value of the smaller key first and the result is deterministic.
+// Panics on colliding keys if resolve is nil
+func MapKeys[T1 constraints.Ordered, T3 comparable, T2 any](m map[T1]T2, f func(T1) T3, resolve func(a, b T2) T2) map[T3]T2 {
+	out := make(map[T3]T2, len(m))
+	for _, k := range SortedKeys(m) {
+		key, v := f(k), m[k]
+
+		if existing, ok := out[key]; ok {
+			if resolve == nil {
+				panic(fmt.Sprintf("key %v is not unique, points to %v and %v", key, existing, v))
+			}
+
+			v = resolve(existing, v)
+		}
+
+		out[key] = v
+	}
+
+	return out
+}
+
+// Invert returns a new map with keys and values swapped. Panics if strictUniqueness is set and multiple keys have the same value,
+// otherwise the largest of the colliding keys is kept
+func Invert[T1 constraints.Ordered, T2 comparable](m map[T1]T2, strictUniqueness ...bool) map[T2]T1 {
+	strict := len(strictUniqueness) > 0 && strictUniqueness[0]
+
+	out := make(map[T2]T1, len(m))
+	for _, k := range SortedKeys(m) {
+		v := m[k]
+		if strict {
+			if existing, ok := out[v]; ok {
+				panic(fmt.Sprintf("value %v is not unique, pointed to by %v and %v", v, existing, k))
+			}
+		}
+
+		out[v] = k
+	}
+
+	return out
+}
+
+// Merge returns a new map containing all entries of the given maps. The maps are merged in order, so if a key exists in multiple maps,
+// resolve is called with the merged value so far and the value of the later map. If resolve is nil, later values override earlier ones
+func Merge[T1 comparable, T2 any](resolve func(key T1, current, next T2) T2, ms ...map[T1]T2) map[T1]T2 {
+	out := make(map[T1]T2)
+	for _, m := range ms {
+		for k, v := range m {
+			if current, ok := out[k]; ok && resolve != nil {
+				v = resolve(k, current, v)
+			}
+
+			out[k] = v
+		}
+	}
+
+	return out
+}
+
+// GetOrDefault returns the value for the given key, or the default value if the key is not included in the map
+func GetOrDefault[T1 comparable, T2 any](m map[T1]T2, key T1, defaultValue T2) T2 {
+	if v, ok := m[key]; ok {
+		return v
+	}
+
+	return defaultValue
+}
+
+// GetOrInsert returns the value for the given key. If the key is not included in the map, the value is created, inserted and returned
+func GetOrInsert[T1 comparable, T2 any](m map[T1]T2, key T1, create func() T2) T2 {
+	if v, ok := m[key]; ok {
+		return v
+	}
+
+	v := create()
+	m[key] = v
+
+	return v
+}
+
+// Equal returns true if both maps contain the same keys and the values for each key are equal according to the given function
+func Equal[T1 comparable, T2 any](a, b map[T1]T2, eq func(T2, T2) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		other, ok := b[k]
+		if !ok || !eq(v, other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Clone returns a shallow copy of the map. Returns nil if the map is nil
+func Clone[T1 comparable, T2 any](m map[T1]T2) map[T1]T2 {
+	if m == nil {
+		return nil
+	}
+
+	return MapValues(m, func(v T2) T2 { return v })
+}
+
+// CloneWith returns a copy of the map where each value is copied with the given function, e.g. to deep clone pointers or slices.
+// Returns nil if the map is nil
+func CloneWith[T1 comparable, T2 any](m map[T1]T2, cloneValue func(T2) T2) map[T1]T2 {
+	if m == nil {
+		return nil
+	}
+
+	return MapValues(m, cloneValue)
+}
@@ -0,0 +1,124 @@
+package maps_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/maps"
+)
+
+var chainIDs = map[string]int{"ethereum": 1, "avalanche": 43114, "polygon": 137}
+
+func TestKeysValues(t *testing.T) {
+	assert.ElementsMatch(t, []string{"ethereum", "avalanche", "polygon"}, maps.Keys(chainIDs))
+	assert.ElementsMatch(t, []int{1, 43114, 137}, maps.Values(chainIDs))
+
+	assert.Equal(t, []string{"avalanche", "ethereum", "polygon"}, maps.SortedKeys(chainIDs))
+	assert.Equal(t, []int{43114, 1, 137}, maps.ValuesSortedByKey(chainIDs))
+
+	assert.Empty(t, maps.Keys(map[string]int{}))
+}
+
+func TestEntries(t *testing.T) {
+	entries := maps.SortedEntries(chainIDs)
+	assert.Equal(t, []maps.Entry[string, int]{{"avalanche", 43114}, {"ethereum", 1}, {"polygon", 137}}, entries)
+	assert.Len(t, maps.Entries(chainIDs), 3)
+
+	assert.Equal(t, chainIDs, maps.FromEntries(maps.Entries(chainIDs)))
+	assert.Equal(t, map[string]int{"a": 2}, maps.FromEntries([]maps.Entry[string, int]{{"a", 1}, {"a", 2}}))
+}
+
+func TestMapValues(t *testing.T) {
+	assert.Equal(t, map[string]string{"ethereum": "1", "avalanche": "43114", "polygon": "137"}, maps.MapValues(chainIDs, strconv.Itoa))
+}
+
+func TestMapKeys(t *testing.T) {
+	assert.Equal(t, map[string]int{"ETHEREUM": 1, "AVALANCHE": 43114, "POLYGON": 137}, maps.MapKeys(chainIDs, strings.ToUpper, nil))
+
+	balances := map[string]int{"Axelar": 1, "axelar": 2, "osmosis": 3}
+	sum := func(a, b int) int { return a + b }
+	assert.Equal(t, map[string]int{"axelar": 3, "osmosis": 3}, maps.MapKeys(balances, strings.ToLower, sum))
+
+	assert.Panics(t, func() { maps.MapKeys(balances, strings.ToLower, nil) })
+
+	// "Axelar" sorts before "axelar", so its value is always passed first
+	first := func(a, _ int) int { return a }
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, map[string]int{"axelar": 1, "osmosis": 3}, maps.MapKeys(balances, strings.ToLower, first))
+	}
+}
+
+func TestInvert(t *testing.T) {
+	assert.Equal(t, map[int]string{1: "ethereum", 43114: "avalanche", 137: "polygon"}, maps.Invert(chainIDs))
+
+	m := map[string]int{"a": 1, "b": 1, "c": 1}
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, map[int]string{1: "c"}, maps.Invert(m))
+	}
+	assert.PanicsWithValue(t, "value 1 is not unique, pointed to by a and b", func() { maps.Invert(m, true) })
+}
+
+func TestMerge(t *testing.T) {
+	a := map[string]int{"a": 1, "b": 2}
+	b := map[string]int{"b": 10, "c": 3}
+	c := map[string]int{"b": 100}
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 100, "c": 3}, maps.Merge(nil, a, b, c))
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 112, "c": 3}, maps.Merge(func(_ string, current, next int) int { return current + next }, a, b, c))
+
+	// keeps the first value
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, maps.Merge(func(_ string, current, _ int) int { return current }, a, b, c))
+	assert.Empty(t, maps.Merge[string, int](nil))
+}
+
+func TestGetOrDefault(t *testing.T) {
+	assert.Equal(t, 1, maps.GetOrDefault(chainIDs, "ethereum", -1))
+	assert.Equal(t, -1, maps.GetOrDefault(chainIDs, "bitcoin", -1))
+}
+
+func TestGetOrInsert(t *testing.T) {
+	m := map[string][]int{"a": {1}}
+
+	calls := 0
+	create := func() []int {
+		calls++
+		return []int{}
+	}
+
+	assert.Equal(t, []int{1}, maps.GetOrInsert(m, "a", create))
+	assert.Equal(t, 0, calls)
+
+	assert.Equal(t, []int{}, maps.GetOrInsert(m, "b", create))
+	assert.Equal(t, 1, calls)
+	assert.Contains(t, m, "b")
+}
+
+func TestEqual(t *testing.T) {
+	eq := func(a, b []int) bool { return len(a) == len(b) }
+
+	assert.True(t, maps.Equal(map[int][]int{1: {1}}, map[int][]int{1: {2}}, eq))
+	assert.False(t, maps.Equal(map[int][]int{1: {1}}, map[int][]int{1: {}}, eq))
+	assert.False(t, maps.Equal(map[int][]int{1: {1}}, map[int][]int{2: {1}}, eq))
+	assert.False(t, maps.Equal(map[int][]int{1: {1}}, map[int][]int{1: {1}, 2: {2}}, eq))
+}
+
+func TestClone(t *testing.T) {
+	m := map[string][]int{"a": {1, 2}}
+
+	shallow := maps.Clone(m)
+	shallow["b"] = nil
+	shallow["a"][0] = 10
+	assert.NotContains(t, m, "b")
+	assert.Equal(t, 10, m["a"][0])
+
+	deep := maps.CloneWith(m, func(v []int) []int { return append([]int{}, v...) })
+	deep["a"][0] = 20
+	assert.Equal(t, 10, m["a"][0])
+
+	assert.Nil(t, maps.Clone[string, int](nil))
+	assert.Nil(t, maps.CloneWith[string, int](nil, func(v int) int { return v }))
+}
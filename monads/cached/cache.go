@@ -1,6 +1,6 @@
 package cached
 
-// Cached wraps a lazy value getter and stores the result for quick access
+// Cached wraps a lazy value getter and stores the result for quick access. It is not safe for concurrent use, see Concurrent instead
 type Cached[T any] struct {
 	value T
 	isSet bool
@@ -0,0 +1,337 @@
+package cached
+
+import (
+	"container/heap"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+)
+
+// EvictionPolicy defines which entry is removed when a Cache reaches its capacity
+type EvictionPolicy int
+
+const (
+	// LRU evicts the least recently used entry
+	LRU EvictionPolicy = iota
+	// LFU evicts the least frequently used entry, ties are broken by recency
+	LFU
+)
+
+// Stats contains the usage metrics of a Cache
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// Options modify the behaviour of the Cache
+type Options func(*config) *config
+
+type config struct {
+	capacity int
+	policy   EvictionPolicy
+	ttl      time.Duration
+	now      func() time.Time
+}
+
+// WithCapacity defines the maximum number of entries. Default is 0, which means unbounded
+func WithCapacity(capacity int) Options {
+	return func(cfg *config) *config {
+		cfg.capacity = capacity
+		return cfg
+	}
+}
+
+// WithEvictionPolicy defines which entry is removed when the cache is full. Default is LRU
+func WithEvictionPolicy(policy EvictionPolicy) Options {
+	return func(cfg *config) *config {
+		cfg.policy = policy
+		return cfg
+	}
+}
+
+// WithTTL defines how long entries stay valid. Default is 0, which means they do not expire
+func WithTTL(ttl time.Duration) Options {
+	return func(cfg *config) *config {
+		cfg.ttl = ttl
+		return cfg
+	}
+}
+
+// WithClock replaces time.Now as the source of the current time, e.g. to control expiry in tests
+func WithClock(now func() time.Time) Options {
+	return func(cfg *config) *config {
+		cfg.now = now
+		return cfg
+	}
+}
+
+// Cache is a keyed cache with optional capacity-based eviction and time-based expiry. It is safe for concurrent use
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	cfg     *config
+	entries map[K]*entry[K, V]
+	queue   evictionQueue[K, V]
+	pending map[K]*load[V]
+	tick    uint64
+	stats   Stats
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+	frequency uint64
+	lastUsed  uint64
+	index     int
+}
+
+// load tracks a running loader so concurrent callers for the same key can wait for its result
+type load[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// NewCache returns a new empty Cache
+func NewCache[K comparable, V any](opts ...Options) *Cache[K, V] {
+	cfg := &config{policy: LRU, now: time.Now}
+	for _, opt := range opts {
+		cfg = opt(cfg)
+	}
+
+	return &Cache[K, V]{
+		cfg:     cfg,
+		entries: make(map[K]*entry[K, V]),
+		queue:   evictionQueue[K, V]{policy: cfg.policy},
+		pending: make(map[K]*load[V]),
+	}
+}
+
+// Get returns the cached value for the given key. Returns false if the key is not cached or has expired
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.lookup(key)
+	if !ok {
+		c.stats.Misses++
+		return *new(V), false
+	}
+
+	c.stats.Hits++
+	return e.value, true
+}
+
+// Set caches the value for the given key with the default TTL
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.cfg.ttl)
+}
+
+// SetWithTTL caches the value for the given key with a custom TTL. A non-positive TTL means the entry does not expire
+func (c *Cache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = c.cfg.now().Add(ttl)
+	}
+
+	if e, ok := c.entries[key]; ok {
+		e.value = value
+		e.expiresAt = expiresAt
+		c.touch(e)
+		return
+	}
+
+	if c.cfg.capacity > 0 && len(c.entries) >= c.cfg.capacity {
+		c.evict()
+	}
+
+	e := &entry[K, V]{key: key, value: value, expiresAt: expiresAt}
+	c.entries[key] = e
+	c.touch(e)
+	heap.Push(&c.queue, e)
+}
+
+// GetOrLoad returns the cached value for the given key. Otherwise, it calls the loader, caches and returns its result.
+// Concurrent calls for the same key share a single loader call. Errors, including panics of the loader, are returned to all waiting callers but are not cached
+func (c *Cache[K, V]) GetOrLoad(key K, loader func(K) (V, error)) (value V, err error) {
+	if v, ok := c.Get(key); ok {
+		return v, nil
+	}
+
+	c.mu.Lock()
+	if e, ok := c.lookup(key); ok {
+		c.mu.Unlock()
+		return e.value, nil
+	}
+
+	if l, ok := c.pending[key]; ok {
+		c.mu.Unlock()
+
+		<-l.done
+		return l.value, l.err
+	}
+
+	l := &load[V]{done: make(chan struct{})}
+	c.pending[key] = l
+	c.mu.Unlock()
+
+	defer func() {
+		if r := recover(); r != nil {
+			l.err = fmt.Errorf("loader panicked: %s\n%s", r, errors.Wrap(r, 1).Stack())
+		}
+
+		if l.err == nil {
+			c.Set(key, l.value)
+		}
+
+		c.mu.Lock()
+		delete(c.pending, key)
+		c.mu.Unlock()
+
+		close(l.done)
+		value, err = l.value, l.err
+	}()
+
+	l.value, l.err = loader(key)
+
+	return l.value, l.err
+}
+
+// Delete removes the given key from the cache
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		c.remove(e)
+	}
+}
+
+// DeleteExpired removes all expired entries. Expired entries are also removed lazily when they are accessed
+func (c *Cache[K, V]) DeleteExpired() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.cfg.now()
+	for _, e := range c.entries {
+		if e.isExpired(now) {
+			c.remove(e)
+			c.stats.Expirations++
+		}
+	}
+}
+
+// Clear removes all entries from the cache
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[K]*entry[K, V])
+	c.queue.entries = nil
+}
+
+// Len returns the number of cached entries, including expired entries that have not been removed yet
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// Stats returns the current usage metrics of the cache
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+// lookup returns the entry for the given key and records the access. Expired entries are removed. Must be called with the lock held
+func (c *Cache[K, V]) lookup(key K) (*entry[K, V], bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if e.isExpired(c.cfg.now()) {
+		c.remove(e)
+		c.stats.Expirations++
+		return nil, false
+	}
+
+	c.touch(e)
+	return e, true
+}
+
+func (c *Cache[K, V]) touch(e *entry[K, V]) {
+	c.tick++
+	e.lastUsed = c.tick
+	e.frequency++
+
+	// entries that are not in the queue yet are positioned when they are pushed
+	if e.index >= 0 && e.index < len(c.queue.entries) && c.queue.entries[e.index] == e {
+		heap.Fix(&c.queue, e.index)
+	}
+}
+
+// evict removes the next entry according to the eviction policy
+func (c *Cache[K, V]) evict() {
+	if len(c.queue.entries) > 0 {
+		c.remove(c.queue.entries[0])
+		c.stats.Evictions++
+	}
+}
+
+func (c *Cache[K, V]) remove(e *entry[K, V]) {
+	heap.Remove(&c.queue, e.index)
+	delete(c.entries, e.key)
+}
+
+func (e *entry[K, V]) isExpired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// evictionQueue is a min-heap of cache entries where the top entry is the next one to be evicted
+type evictionQueue[K comparable, V any] struct {
+	entries []*entry[K, V]
+	policy  EvictionPolicy
+}
+
+func (q *evictionQueue[K, V]) Len() int { return len(q.entries) }
+
+func (q *evictionQueue[K, V]) Less(i, j int) bool {
+	a, b := q.entries[i], q.entries[j]
+	if q.policy == LFU && a.frequency != b.frequency {
+		return a.frequency < b.frequency
+	}
+
+	return a.lastUsed < b.lastUsed
+}
+
+func (q *evictionQueue[K, V]) Swap(i, j int) {
+	q.entries[i], q.entries[j] = q.entries[j], q.entries[i]
+	q.entries[i].index = i
+	q.entries[j].index = j
+}
+
+func (q *evictionQueue[K, V]) Push(x any) {
+	e := x.(*entry[K, V])
+	e.index = len(q.entries)
+	q.entries = append(q.entries, e)
+}
+
+func (q *evictionQueue[K, V]) Pop() any {
+	last := len(q.entries) - 1
+	e := q.entries[last]
+	q.entries[last] = nil
+	q.entries = q.entries[:last]
+	e.index = -1
+	return e
+}
@@ -0,0 +1,148 @@
+package cached_test
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/monads/cached"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func TestCache(t *testing.T) {
+	c := cached.NewCache[string, int]()
+
+	c.Set("a", 1)
+	assert.Equal(t, 1, funcs.MustOk(c.Get("a")))
+
+	_, ok := c.Get("b")
+	assert.False(t, ok)
+
+	c.Delete("a")
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+
+	c.Set("a", 1)
+	c.Clear()
+	assert.Equal(t, 0, c.Len())
+
+	assert.Equal(t, cached.Stats{Hits: 1, Misses: 2}, c.Stats())
+}
+
+func TestCache_LRU(t *testing.T) {
+	c := cached.NewCache[string, int](cached.WithCapacity(2))
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Set("c", 3)
+
+	_, ok := c.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 1, funcs.MustOk(c.Get("a")))
+	assert.Equal(t, 3, funcs.MustOk(c.Get("c")))
+	assert.Equal(t, 2, c.Len())
+	assert.EqualValues(t, 1, c.Stats().Evictions)
+}
+
+func TestCache_LFU(t *testing.T) {
+	c := cached.NewCache[string, int](cached.WithCapacity(2), cached.WithEvictionPolicy(cached.LFU))
+
+	c.Set("a", 1)
+	c.Get("a")
+	c.Get("a")
+	c.Set("b", 2)
+	c.Get("b")
+	c.Set("c", 3)
+
+	_, ok := c.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 1, funcs.MustOk(c.Get("a")))
+	assert.Equal(t, 3, funcs.MustOk(c.Get("c")))
+}
+
+func TestCache_TTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	c := cached.NewCache[string, int](cached.WithTTL(time.Minute), cached.WithClock(clock.Now))
+
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, time.Hour)
+	c.SetWithTTL("c", 3, 0)
+
+	clock.Advance(59 * time.Second)
+	assert.Equal(t, 1, funcs.MustOk(c.Get("a")))
+
+	clock.Advance(time.Second)
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 2, funcs.MustOk(c.Get("b")))
+
+	clock.Advance(time.Hour)
+	c.DeleteExpired()
+	assert.Equal(t, 1, c.Len())
+	assert.Equal(t, 3, funcs.MustOk(c.Get("c")))
+	assert.EqualValues(t, 2, c.Stats().Expirations)
+}
+
+func TestCache_GetOrLoad(t *testing.T) {
+	c := cached.NewCache[int, string]()
+
+	var calls atomic.Int64
+	loader := func(k int) (string, error) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return strconv.Itoa(k), nil
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, "7", funcs.Must(c.GetOrLoad(7, loader)))
+		}()
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 1, calls.Load())
+	assert.Equal(t, "7", funcs.MustOk(c.Get(7)))
+}
+
+func TestCache_GetOrLoad_Error(t *testing.T) {
+	c := cached.NewCache[int, string]()
+
+	_, err := c.GetOrLoad(1, func(int) (string, error) { return "", errors.New("failed") })
+	assert.EqualError(t, err, "failed")
+	assert.Equal(t, 0, c.Len())
+
+	_, err = c.GetOrLoad(1, func(int) (string, error) { panic("unexpected") })
+	assert.ErrorContains(t, err, "loader panicked: unexpected")
+
+	v, err := c.GetOrLoad(1, func(int) (string, error) { return "1", nil })
+	assert.NoError(t, err)
+	assert.Equal(t, "1", v)
+}
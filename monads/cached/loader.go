@@ -0,0 +1,169 @@
+package cached
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+)
+
+// Concurrent wraps a lazy value getter and stores the result for quick access. In contrast to Cached, it is safe for concurrent use
+// and the getter is only called once even if multiple goroutines request the value at the same time
+type Concurrent[T any] struct {
+	loader *Loader[T]
+}
+
+// NewConcurrent returns a new cached value that is safe for concurrent use
+func NewConcurrent[T any](getValue func() T) *Concurrent[T] {
+	return &Concurrent[T]{
+		loader: NewLoader(func() (T, error) { return getValue(), nil }),
+	}
+}
+
+// Value returns the cached value, calling the getter if it has not been set yet
+func (c *Concurrent[T]) Value() T {
+	v, _ := c.loader.Value()
+	return v
+}
+
+// Clear resets the cached value so the getter is called again on the next access
+func (c *Concurrent[T]) Clear() {
+	c.loader.Clear()
+}
+
+// LoaderOptions modify the behaviour of the Loader
+type LoaderOptions func(*loaderConfig) *loaderConfig
+
+type loaderConfig struct {
+	ttl               time.Duration
+	now               func() time.Time
+	backgroundRefresh bool
+}
+
+// WithLoaderTTL defines how long a loaded value stays valid. Default is 0, which means it does not expire
+func WithLoaderTTL(ttl time.Duration) LoaderOptions {
+	return func(cfg *loaderConfig) *loaderConfig {
+		cfg.ttl = ttl
+		return cfg
+	}
+}
+
+// WithBackgroundRefresh makes the Loader return its expired value while a new value is loaded in the background.
+// Only the very first load blocks the caller
+func WithBackgroundRefresh() LoaderOptions {
+	return func(cfg *loaderConfig) *loaderConfig {
+		cfg.backgroundRefresh = true
+		return cfg
+	}
+}
+
+// WithLoaderClock replaces time.Now as the source of the current time, e.g. to control expiry in tests
+func WithLoaderClock(now func() time.Time) LoaderOptions {
+	return func(cfg *loaderConfig) *loaderConfig {
+		cfg.now = now
+		return cfg
+	}
+}
+
+// Loader wraps a fallible lazy value getter and stores the result for quick access. Failed loads are not cached.
+// With the WithLoaderTTL option, the value is reloaded once it expires. It is safe for concurrent use
+type Loader[T any] struct {
+	mu         sync.Mutex
+	load       func() (T, error)
+	cfg        *loaderConfig
+	value      T
+	isSet      bool
+	loadedAt   time.Time
+	refreshing bool
+	// generation is incremented on Clear so results of refreshes that started before are discarded
+	generation uint64
+}
+
+// NewLoader returns a new cached value backed by the given loader
+func NewLoader[T any](load func() (T, error), opts ...LoaderOptions) *Loader[T] {
+	cfg := &loaderConfig{now: time.Now}
+	for _, opt := range opts {
+		cfg = opt(cfg)
+	}
+
+	return &Loader[T]{
+		load: load,
+		cfg:  cfg,
+	}
+}
+
+// Value returns the cached value. If it has not been set yet or has expired, the loader is called while concurrent callers wait for its result.
+// In background refresh mode, an expired value is returned immediately while it is reloaded in the background.
+// Returns the loader's error if the value cannot be loaded
+func (l *Loader[T]) Value() (T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.isSet && !l.isExpired() {
+		return l.value, nil
+	}
+
+	if l.isSet && l.cfg.backgroundRefresh {
+		if !l.refreshing {
+			l.refreshing = true
+			go l.refresh(l.generation)
+		}
+
+		return l.value, nil
+	}
+
+	v, err := l.load()
+	if err != nil {
+		return *new(T), err
+	}
+
+	l.set(v)
+	return v, nil
+}
+
+// Clear resets the cached value so the loader is called again on the next access
+func (l *Loader[T]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.isSet = false
+	l.value = *new(T)
+	l.generation++
+}
+
+func (l *Loader[T]) refresh(generation uint64) {
+	v, err := l.safeLoad()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refreshing = false
+	// keep serving the stale value if the refresh failed, the next access triggers another attempt
+	if err != nil || generation != l.generation {
+		return
+	}
+
+	l.set(v)
+}
+
+// safeLoad calls the loader and converts panics into errors, so a failing background refresh cannot crash the process
+func (l *Loader[T]) safeLoad() (v T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("loader panicked: %s\n%s", r, errors.Wrap(r, 1).Stack())
+		}
+	}()
+
+	return l.load()
+}
+
+func (l *Loader[T]) set(v T) {
+	l.value = v
+	l.isSet = true
+	l.loadedAt = l.cfg.now()
+}
+
+func (l *Loader[T]) isExpired() bool {
+	return l.cfg.ttl > 0 && !l.cfg.now().Before(l.loadedAt.Add(l.cfg.ttl))
+}
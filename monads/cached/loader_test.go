@@ -0,0 +1,121 @@
+package cached_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/monads/cached"
+)
+
+func TestConcurrent(t *testing.T) {
+	var calls atomic.Int64
+	val := cached.NewConcurrent(func() int64 {
+		time.Sleep(10 * time.Millisecond)
+		return calls.Add(1)
+	})
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.EqualValues(t, 1, val.Value())
+		}()
+	}
+	wg.Wait()
+
+	val.Clear()
+	assert.EqualValues(t, 2, val.Value())
+}
+
+func TestLoader_Error(t *testing.T) {
+	fail := true
+	calls := 0
+	val := cached.NewLoader(func() (int, error) {
+		calls++
+		if fail {
+			return 0, errors.New("unavailable")
+		}
+		return 5, nil
+	})
+
+	_, err := val.Value()
+	assert.Error(t, err)
+
+	fail = false
+	assert.Equal(t, 5, funcs.Must(val.Value()))
+	assert.Equal(t, 5, funcs.Must(val.Value()))
+	assert.Equal(t, 2, calls)
+}
+
+func TestLoader_TTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+
+	var calls atomic.Int64
+	val := cached.NewLoader(func() (int64, error) { return calls.Add(1), nil }, cached.WithLoaderTTL(time.Minute), cached.WithLoaderClock(clock.Now))
+
+	assert.EqualValues(t, 1, funcs.Must(val.Value()))
+
+	clock.Advance(59 * time.Second)
+	assert.EqualValues(t, 1, funcs.Must(val.Value()))
+
+	clock.Advance(time.Second)
+	assert.EqualValues(t, 2, funcs.Must(val.Value()))
+
+	val.Clear()
+	assert.EqualValues(t, 3, funcs.Must(val.Value()))
+}
+
+func TestLoader_BackgroundRefresh(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+
+	var calls atomic.Int64
+	release := make(chan struct{})
+	val := cached.NewLoader(func() (int64, error) {
+		n := calls.Add(1)
+		if n > 1 {
+			<-release
+		}
+		return n, nil
+	}, cached.WithLoaderTTL(time.Minute), cached.WithBackgroundRefresh(), cached.WithLoaderClock(clock.Now))
+
+	assert.EqualValues(t, 1, funcs.Must(val.Value()))
+
+	clock.Advance(time.Minute)
+
+	// serves the stale value while the refresh is blocked, without triggering more refreshes
+	assert.EqualValues(t, 1, funcs.Must(val.Value()))
+	assert.EqualValues(t, 1, funcs.Must(val.Value()))
+
+	close(release)
+	assert.Eventually(t, func() bool { return funcs.Must(val.Value()) == 2 }, time.Second, 5*time.Millisecond)
+	assert.EqualValues(t, 2, calls.Load())
+}
+
+func TestLoader_BackgroundRefreshFailure(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+
+	var calls atomic.Int64
+	val := cached.NewLoader(func() (int64, error) {
+		if calls.Add(1) > 1 {
+			panic("unavailable")
+		}
+		return 1, nil
+	}, cached.WithLoaderTTL(time.Minute), cached.WithBackgroundRefresh(), cached.WithLoaderClock(clock.Now))
+
+	assert.EqualValues(t, 1, funcs.Must(val.Value()))
+
+	clock.Advance(time.Minute)
+	assert.EqualValues(t, 1, funcs.Must(val.Value()))
+
+	// failed refreshes are retried on later accesses while the stale value is kept
+	assert.Eventually(t, func() bool {
+		return funcs.Must(val.Value()) == 1 && calls.Load() > 2
+	}, time.Second, 5*time.Millisecond)
+}
@@ -0,0 +1,124 @@
+package either
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/axelarnetwork/utils/monads/results"
+)
+
+// ErrNilLeft is the error of a Result converted from an Either whose left value is a nil error, e.g. the zero value of Either
+var ErrNilLeft = errors.New("either holds a nil error as left value")
+
+// Either holds exactly one of two possible values, either a left value of type L or a right value of type R
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left returns an Either holding the given left value
+func Left[L, R any](value L) Either[L, R] {
+	return Either[L, R]{left: value}
+}
+
+// Right returns an Either holding the given right value
+func Right[L, R any](value R) Either[L, R] {
+	return Either[L, R]{right: value, isRight: true}
+}
+
+// FromResult returns an Either holding the error of the Result as left value, or its value as right value if there is no error
+func FromResult[T any](res results.Result[T]) Either[error, T] {
+	if res.Err() != nil {
+		return Left[error, T](res.Err())
+	}
+	return Right[error](res.Ok())
+}
+
+// IsLeft returns true if the Either holds a left value
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight returns true if the Either holds a right value
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// GetLeft returns the left value and true if the Either holds one, the zero value of L and false otherwise
+func (e Either[L, R]) GetLeft() (L, bool) {
+	return e.left, !e.isRight
+}
+
+// GetRight returns the right value and true if the Either holds one, the zero value of R and false otherwise
+func (e Either[L, R]) GetRight() (R, bool) {
+	return e.right, e.isRight
+}
+
+// Swap turns a left value into a right value and vice versa
+func (e Either[L, R]) Swap() Either[R, L] {
+	if e.isRight {
+		return Left[R, L](e.right)
+	}
+	return Right[R](e.left)
+}
+
+// Match executes onLeft or onRight depending on the value held by the Either
+func (e Either[L, R]) Match(onLeft func(L), onRight func(R)) {
+	if e.isRight {
+		onRight(e.right)
+		return
+	}
+	onLeft(e.left)
+}
+
+// Fold reduces the Either to a single value by executing onLeft or onRight depending on the value it holds
+func Fold[L, R, T any](e Either[L, R], onLeft func(L) T, onRight func(R) T) T {
+	if e.isRight {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
+
+// MapLeft transforms the left value to the new type if present, returns the right value unchanged otherwise
+func MapLeft[L1, L2, R any](e Either[L1, R], f func(L1) L2) Either[L2, R] {
+	if e.isRight {
+		return Right[L2](e.right)
+	}
+	return Left[L2, R](f(e.left))
+}
+
+// MapRight transforms the right value to the new type if present, returns the left value unchanged otherwise
+func MapRight[L, R1, R2 any](e Either[L, R1], f func(R1) R2) Either[L, R2] {
+	if e.isRight {
+		return Right[L](f(e.right))
+	}
+	return Left[L, R2](e.left)
+}
+
+// ToResult turns an Either with an error as left value into a Result. A left value is always a failure,
+// so a nil error, including a typed nil pointer, is replaced with ErrNilLeft
+func ToResult[E error, R any](e Either[E, R]) results.Result[R] {
+	if e.isRight {
+		return results.FromOk(e.right)
+	}
+	if isNil(e.left) {
+		return results.FromErr[R](ErrNilLeft)
+	}
+	return results.FromErr[R](e.left)
+}
+
+// isNil returns true if the error is nil or an interface holding a nil value of a pointer-like type
+func isNil(err error) bool {
+	if err == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(err)
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.Interface:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
@@ -0,0 +1,88 @@
+package either_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/monads/either"
+	"github.com/axelarnetwork/utils/monads/results"
+)
+
+func TestEither(t *testing.T) {
+	t.Run("constructors", func(t *testing.T) {
+		left := either.Left[string, int]("local")
+		assert.True(t, left.IsLeft())
+		assert.False(t, left.IsRight())
+		assert.Equal(t, "local", funcs.MustOk(left.GetLeft()))
+		_, ok := left.GetRight()
+		assert.False(t, ok)
+
+		right := either.Right[string](5)
+		assert.True(t, right.IsRight())
+		assert.False(t, right.IsLeft())
+		assert.Equal(t, 5, funcs.MustOk(right.GetRight()))
+		_, ok = right.GetLeft()
+		assert.False(t, ok)
+
+		// the zero value holds the zero left value
+		assert.True(t, either.Either[string, int]{}.IsLeft())
+	})
+
+	t.Run("Swap", func(t *testing.T) {
+		assert.Equal(t, either.Right[int]("local"), either.Left[string, int]("local").Swap())
+		assert.Equal(t, either.Left[int, string](5), either.Right[string](5).Swap())
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		var matched []string
+		onLeft := func(s string) { matched = append(matched, "left "+s) }
+		onRight := func(i int) { matched = append(matched, "right "+strconv.Itoa(i)) }
+
+		either.Left[string, int]("local").Match(onLeft, onRight)
+		either.Right[string](5).Match(onLeft, onRight)
+
+		assert.Equal(t, []string{"left local", "right 5"}, matched)
+	})
+
+	t.Run("Fold", func(t *testing.T) {
+		length := func(s string) int { return len(s) }
+		double := func(i int) int { return 2 * i }
+
+		assert.Equal(t, 5, either.Fold(either.Left[string, int]("local"), length, double))
+		assert.Equal(t, 10, either.Fold(either.Right[string](5), length, double))
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		assert.Equal(t, either.Left[int, int](5), either.MapLeft(either.Left[string, int]("local"), func(s string) int { return len(s) }))
+		assert.Equal(t, either.Right[int](5), either.MapLeft(either.Right[string](5), func(s string) int { return len(s) }))
+
+		assert.Equal(t, either.Right[string]("5"), either.MapRight(either.Right[string](5), strconv.Itoa))
+		assert.Equal(t, either.Left[string, string]("local"), either.MapRight(either.Left[string, int]("local"), strconv.Itoa))
+	})
+
+	t.Run("Result", func(t *testing.T) {
+		err := errors.New("rejected")
+
+		assert.Equal(t, results.FromOk(5), either.ToResult(either.Right[error](5)))
+		assert.Equal(t, results.FromErr[int](err), either.ToResult(either.Left[error, int](err)))
+
+		assert.Equal(t, either.Right[error](5), either.FromResult(results.FromOk(5)))
+		assert.Equal(t, either.Left[error, int](err), either.FromResult(results.FromErr[int](err)))
+	})
+
+	t.Run("Result from nil left value", func(t *testing.T) {
+		assert.ErrorIs(t, either.ToResult(either.Either[error, int]{}).Err(), either.ErrNilLeft)
+		assert.ErrorIs(t, either.ToResult(either.Left[error, int](nil)).Err(), either.ErrNilLeft)
+		assert.ErrorIs(t, either.ToResult(either.Left[*customErr, int](nil)).Err(), either.ErrNilLeft)
+
+		assert.Equal(t, &customErr{}, either.ToResult(either.Left[*customErr, int](&customErr{})).Err())
+	})
+}
+
+type customErr struct{}
+
+func (*customErr) Error() string { return "custom" }
@@ -0,0 +1,259 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	goerrors "github.com/go-errors/errors"
+
+	"github.com/axelarnetwork/utils/chans"
+	"github.com/axelarnetwork/utils/monads/results"
+)
+
+// Future is the Result of an asynchronous computation that becomes available once the computation completes.
+// Use Go or FromResult to create one, the zero value is not usable.
+type Future[T any] struct {
+	p *promise[T]
+}
+
+// Go runs f in a new goroutine and returns a Future of its result. A panic in f results in an error.
+// If ctx expires before f returns, the Future fails with the context's error and the eventual result of f is discarded.
+func Go[T any](ctx context.Context, f func() (T, error)) Future[T] {
+	p := newPromise[T]()
+
+	if ctx.Err() != nil {
+		p.resolve(results.FromErr[T](ctx.Err()))
+		return Future[T]{p: p}
+	}
+
+	stop := context.AfterFunc(ctx, func() { p.resolve(results.FromErr[T](ctx.Err())) })
+	go func() {
+		defer stop()
+		p.resolve(safeCall(f))
+	}()
+
+	return Future[T]{p: p}
+}
+
+// FromResult returns an already completed Future
+func FromResult[T any](res results.Result[T]) Future[T] {
+	p := newPromise[T]()
+	p.resolve(res)
+	return Future[T]{p: p}
+}
+
+// Await blocks until the Future completes and returns its Result. Returns the context's error if ctx expires first.
+func (f Future[T]) Await(ctx context.Context) results.Result[T] {
+	select {
+	case <-f.p.done:
+		return f.p.result
+	default:
+	}
+
+	select {
+	case <-f.p.done:
+		return f.p.result
+	case <-ctx.Done():
+		return results.FromErr[T](ctx.Err())
+	}
+}
+
+// Done returns a channel that is closed when the Future completes
+func (f Future[T]) Done() <-chan struct{} {
+	return f.p.done
+}
+
+// Chan returns a channel that receives the Result once the Future completes and is closed afterwards
+func (f Future[T]) Chan() <-chan results.Result[T] {
+	out := make(chan results.Result[T], 1)
+
+	go func() {
+		defer close(out)
+		<-f.p.done
+		out <- f.p.result
+	}()
+
+	return out
+}
+
+// WithTimeout returns a Future that fails with context.DeadlineExceeded if f does not complete within the given duration
+func (f Future[T]) WithTimeout(timeout time.Duration) Future[T] {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+
+	return Go(ctx, func() (T, error) {
+		defer cancel()
+		return f.Await(ctx).Get()
+	})
+}
+
+// Then runs g with the value of f once it completes successfully. Returns f's error otherwise
+func Then[T1, T2 any](ctx context.Context, f Future[T1], g func(T1) (T2, error)) Future[T2] {
+	return Go(ctx, func() (T2, error) {
+		value, err := f.Await(ctx).Get()
+		if err != nil {
+			return *new(T2), err
+		}
+
+		return g(value)
+	})
+}
+
+// All returns a Future of all values in the order of the given futures. Fails as soon as any of the futures fails
+func All[T any](ctx context.Context, futures ...Future[T]) Future[[]T] {
+	return Go(ctx, func() ([]T, error) {
+		out := make([]T, len(futures))
+
+		var err error
+		consumeErr := consumeCompleted(ctx, futures, func(i int, res results.Result[T]) bool {
+			if res.Err() != nil {
+				err = res.Err()
+				return false
+			}
+
+			out[i] = res.Ok()
+			return true
+		})
+		if err := errors.Join(err, consumeErr); err != nil {
+			return nil, err
+		}
+
+		return out, nil
+	})
+}
+
+// Any returns a Future of the first successfully computed value. Fails with all errors joined if all futures fail
+func Any[T any](ctx context.Context, futures ...Future[T]) Future[T] {
+	return Go(ctx, func() (T, error) {
+		if len(futures) == 0 {
+			return *new(T), errors.New("no futures given")
+		}
+
+		var (
+			value T
+			found bool
+			errs  []error
+		)
+		err := consumeCompleted(ctx, futures, func(_ int, res results.Result[T]) bool {
+			if res.Err() != nil {
+				errs = append(errs, res.Err())
+				return true
+			}
+
+			value, found = res.Ok(), true
+			return false
+		})
+
+		switch {
+		case err != nil:
+			return *new(T), err
+		case !found:
+			return *new(T), errors.Join(errs...)
+		default:
+			return value, nil
+		}
+	})
+}
+
+// Race returns a Future of the first completed future's Result, regardless of whether it succeeded or failed
+func Race[T any](ctx context.Context, futures ...Future[T]) Future[T] {
+	return Go(ctx, func() (T, error) {
+		if len(futures) == 0 {
+			return *new(T), errors.New("no futures given")
+		}
+
+		var first results.Result[T]
+		if err := consumeCompleted(ctx, futures, func(_ int, res results.Result[T]) bool {
+			first = res
+			return false
+		}); err != nil {
+			return *new(T), err
+		}
+
+		return first.Get()
+	})
+}
+
+// AsCompleted returns a channel that receives the Results of the given futures in the order they complete.
+// The channel is closed when all futures have completed or the context expires.
+func AsCompleted[T any](ctx context.Context, futures ...Future[T]) <-chan results.Result[T] {
+	out := make(chan results.Result[T])
+
+	go func() {
+		defer close(out)
+
+		_ = consumeCompleted(ctx, futures, func(_ int, res results.Result[T]) bool {
+			return chans.Push(ctx, out, res)
+		})
+	}()
+
+	return out
+}
+
+type indexedResult[T any] struct {
+	index  int
+	result results.Result[T]
+}
+
+// consumeCompleted calls f with the index and Result of each future in the order they complete,
+// until f returns false, all futures have completed or the context expires. Only returns an error if the context expires.
+func consumeCompleted[T any](ctx context.Context, futures []Future[T], f func(int, results.Result[T]) bool) error {
+	// stops the goroutines waiting on futures that have not completed once consumption ends
+	waitCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	// buffered so waiting goroutines can always finish, even if the consumer stops early
+	completed := make(chan indexedResult[T], len(futures))
+	for i, future := range futures {
+		go func() {
+			select {
+			case <-future.p.done:
+				completed <- indexedResult[T]{index: i, result: future.p.result}
+			case <-waitCtx.Done():
+			}
+		}()
+	}
+
+	for range futures {
+		next, ok := chans.Pop(ctx, completed)
+		if !ok {
+			return ctx.Err()
+		}
+
+		if !f(next.index, next.result) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+type promise[T any] struct {
+	once   sync.Once
+	done   chan struct{}
+	result results.Result[T]
+}
+
+func newPromise[T any]() *promise[T] {
+	return &promise[T]{done: make(chan struct{})}
+}
+
+// resolve sets the result of the promise. Only the first call has an effect
+func (p *promise[T]) resolve(res results.Result[T]) {
+	p.once.Do(func() {
+		p.result = res
+		close(p.done)
+	})
+}
+
+func safeCall[T any](f func() (T, error)) (res results.Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = results.FromErr[T](fmt.Errorf("function panicked: %s\n%s", r, goerrors.Wrap(r, 1).Stack()))
+		}
+	}()
+
+	return results.New(f())
+}
@@ -0,0 +1,250 @@
+package future_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/chans"
+	"github.com/axelarnetwork/utils/monads/future"
+	"github.com/axelarnetwork/utils/monads/results"
+)
+
+func TestGo(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		f := future.Go(context.Background(), func() (int, error) { return 5, nil })
+		assert.Equal(t, results.FromOk(5), f.Await(context.Background()))
+
+		// awaiting again returns the same result without re-running the function
+		assert.Equal(t, results.FromOk(5), f.Await(context.Background()))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		f := future.Go(context.Background(), func() (int, error) { return 0, errors.New("some error") })
+		assert.EqualError(t, f.Await(context.Background()).Err(), "some error")
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		f := future.Go(context.Background(), func() (int, error) { panic("unexpected value") })
+		assert.ErrorContains(t, f.Await(context.Background()).Err(), "function panicked: unexpected value")
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var called atomic.Bool
+		f := future.Go(ctx, func() (int, error) {
+			called.Store(true)
+			return 5, nil
+		})
+		assert.ErrorIs(t, f.Await(context.Background()).Err(), context.Canceled)
+		assert.False(t, called.Load())
+	})
+
+	t.Run("context cancelled while running", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		release := make(chan struct{})
+		defer close(release)
+
+		f := future.Go(ctx, func() (int, error) {
+			<-release
+			return 5, nil
+		})
+
+		cancel()
+		assert.ErrorIs(t, f.Await(context.Background()).Err(), context.Canceled)
+	})
+}
+
+func TestFuture_Await(t *testing.T) {
+	f, _ := pending(t, 5, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, f.Await(ctx).Err(), context.DeadlineExceeded)
+
+	select {
+	case <-f.Done():
+		assert.Fail(t, "future should still be running")
+	default:
+	}
+}
+
+func TestFuture_WithTimeout(t *testing.T) {
+	slow, _ := pending(t, 5, nil)
+	assert.ErrorIs(t, slow.WithTimeout(10*time.Millisecond).Await(context.Background()).Err(), context.DeadlineExceeded)
+
+	fast := future.FromResult(results.FromOk(5))
+	assert.Equal(t, results.FromOk(5), fast.WithTimeout(time.Second).Await(context.Background()))
+}
+
+func TestFuture_Chan(t *testing.T) {
+	f := future.FromResult(results.FromOk(5))
+
+	out, err := chans.Collect(context.Background(), f.Chan())
+	assert.NoError(t, err)
+	assert.Equal(t, []results.Result[int]{results.FromOk(5)}, out)
+}
+
+func TestThen(t *testing.T) {
+	parse := func(s string) (int, error) { return strconv.Atoi(s) }
+
+	f := future.Then(context.Background(), future.FromResult(results.FromOk("5")), parse)
+	assert.Equal(t, results.FromOk(5), f.Await(context.Background()))
+
+	f = future.Then(context.Background(), future.FromResult(results.FromOk("x")), parse)
+	assert.ErrorIs(t, f.Await(context.Background()).Err(), strconv.ErrSyntax)
+
+	var called atomic.Bool
+	f = future.Then(context.Background(), future.FromResult(results.FromErr[string](errors.New("some error"))), func(s string) (int, error) {
+		called.Store(true)
+		return parse(s)
+	})
+	assert.EqualError(t, f.Await(context.Background()).Err(), "some error")
+	assert.False(t, called.Load())
+}
+
+func TestAll(t *testing.T) {
+	ctx := context.Background()
+
+	f1, release1 := pending(t, 1, nil)
+	f2, release2 := pending(t, 2, nil)
+	f3, release3 := pending(t, 3, nil)
+	f := future.All(ctx, f1, f2, f3)
+
+	// values keep the order of the futures, not the order of completion
+	release3()
+	release2()
+	release1()
+	assert.Equal(t, results.FromOk([]int{1, 2, 3}), f.Await(ctx))
+
+	// fails without waiting for the unfinished future
+	unfinished, _ := pending(t, 1, nil)
+	failed := future.FromResult(results.FromErr[int](errors.New("some error")))
+	assert.EqualError(t, awaitWithTimeout(t, future.All(ctx, unfinished, failed)).Err(), "some error")
+
+	assert.Equal(t, results.FromOk([]int{}), future.All[int](ctx).Await(ctx))
+}
+
+func TestAny(t *testing.T) {
+	ctx := context.Background()
+
+	unfinished, _ := pending(t, 3, nil)
+	failed := future.FromResult(results.FromErr[int](errors.New("some error")))
+	succeeded, release := pending(t, 2, nil)
+	f := future.Any(ctx, failed, succeeded, unfinished)
+
+	release()
+	assert.Equal(t, results.FromOk(2), awaitWithTimeout(t, f))
+
+	f = future.Any(ctx, future.FromResult(results.FromErr[int](errors.New("first error"))), future.FromResult(results.FromErr[int](errors.New("second error"))))
+	err := f.Await(ctx).Err()
+	assert.ErrorContains(t, err, "first error")
+	assert.ErrorContains(t, err, "second error")
+
+	assert.Error(t, future.Any[int](ctx).Await(ctx).Err())
+}
+
+func TestRace(t *testing.T) {
+	ctx := context.Background()
+
+	unfinished, _ := pending(t, 1, nil)
+
+	f := future.Race(ctx, unfinished, future.FromResult(results.FromErr[int](errors.New("some error"))))
+	assert.EqualError(t, awaitWithTimeout(t, f).Err(), "some error")
+
+	f = future.Race(ctx, unfinished, future.FromResult(results.FromOk(2)))
+	assert.Equal(t, results.FromOk(2), awaitWithTimeout(t, f))
+
+	assert.Error(t, future.Race[int](ctx).Await(ctx).Err())
+}
+
+func TestRace_ReleasesWaitingGoroutines(t *testing.T) {
+	ctx := context.Background()
+
+	unfinished, _ := pending(t, 1, nil)
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 10; i++ {
+		f := future.Race(ctx, unfinished, future.FromResult(results.FromOk(2)))
+		assert.Equal(t, results.FromOk(2), awaitWithTimeout(t, f))
+	}
+
+	// polling manually, because assert.Eventually starts goroutines of its own
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			assert.Fail(t, "goroutines leaked", "%d goroutines before, %d after", before, runtime.NumGoroutine())
+			return
+		}
+	}
+}
+
+func TestAsCompleted(t *testing.T) {
+	ctx := context.Background()
+
+	f1, release1 := pending(t, 1, nil)
+	f2, release2 := pending(t, 2, nil)
+	f3, release3 := pending(t, 0, errors.New("some error"))
+	out := future.AsCompleted(ctx, f1, f2, f3)
+
+	// each future is only released once the previous result has been received, so the completion order is fixed
+	release2()
+	assert.Equal(t, results.FromOk(2), <-out)
+	release3()
+	assert.EqualError(t, (<-out).Err(), "some error")
+	release1()
+	assert.Equal(t, results.FromOk(1), <-out)
+
+	_, ok := <-out
+	assert.False(t, ok)
+}
+
+func TestAsCompleted_ChansOperators(t *testing.T) {
+	ctx := context.Background()
+
+	out := future.AsCompleted(ctx,
+		future.FromResult(results.FromOk(1)),
+		future.FromResult(results.FromErr[int](errors.New("some error"))),
+		future.FromResult(results.FromOk(2)),
+	)
+	oks := chans.Map(chans.Filter(out, results.Result[int].IsOk), results.Must[int])
+
+	values, err := chans.Collect(ctx, oks)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int{1, 2}, values)
+}
+
+// pending returns a future that completes with the given value and error once release is called.
+// Unreleased futures are released when the test finishes
+func pending(t *testing.T, value int, err error) (future.Future[int], func()) {
+	released := make(chan struct{})
+	release := sync.OnceFunc(func() { close(released) })
+	t.Cleanup(release)
+
+	f := future.Go(context.Background(), func() (int, error) {
+		<-released
+		return value, err
+	})
+
+	return f, release
+}
+
+// awaitWithTimeout fails the test if the future does not complete in time, e.g. because it waits on an unreleased future
+func awaitWithTimeout[T any](t *testing.T, f future.Future[T]) results.Result[T] {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res := f.Await(ctx)
+	assert.NotErrorIs(t, res.Err(), context.DeadlineExceeded, "future did not complete in time")
+	return res
+}
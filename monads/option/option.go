@@ -0,0 +1,162 @@
+package option
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/axelarnetwork/utils/monads/results"
+)
+
+// Option wraps a value that might not be present
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some returns an Option holding the given value
+func Some[T any](value T) Option[T] {
+	return Option[T]{value: value, ok: true}
+}
+
+// None returns an empty Option
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// New wraps the idiomatic tuple of (value, ok) in an Option
+func New[T any](value T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// FromPtr returns None if the pointer is nil, otherwise Some with the dereferenced value
+func FromPtr[T any](ptr *T) Option[T] {
+	if ptr == nil {
+		return None[T]()
+	}
+	return Some(*ptr)
+}
+
+// FromResult returns None if the result holds an error, otherwise Some with the result's value
+func FromResult[T any](res results.Result[T]) Option[T] {
+	if res.Err() != nil {
+		return None[T]()
+	}
+	return Some(res.Ok())
+}
+
+// IsSome returns true if the Option holds a value
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the Option is empty
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the idiomatic tuple of (value, ok). The value is the zero value of T if the Option is empty
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// OrElse returns the held value, or the fallback if the Option is empty
+func (o Option[T]) OrElse(fallback T) T {
+	if !o.ok {
+		return fallback
+	}
+	return o.value
+}
+
+// OrElseGet returns the held value, or the result of f if the Option is empty. f is only evaluated when needed
+func (o Option[T]) OrElseGet(f func() T) T {
+	if !o.ok {
+		return f()
+	}
+	return o.value
+}
+
+// Filter returns the Option unchanged if it holds a value that matches the predicate, None otherwise
+func (o Option[T]) Filter(predicate func(T) bool) Option[T] {
+	if !o.ok || !predicate(o.value) {
+		return None[T]()
+	}
+	return o
+}
+
+// Ptr returns a pointer to a copy of the held value, or nil if the Option is empty
+func (o Option[T]) Ptr() *T {
+	if !o.ok {
+		return nil
+	}
+	value := o.value
+	return &value
+}
+
+// ToResult returns a Result with the held value, or with the given error if the Option is empty
+func (o Option[T]) ToResult(err error) results.Result[T] {
+	if !o.ok {
+		return results.FromErr[T](err)
+	}
+	return results.FromOk(o.value)
+}
+
+// Map transforms the held value to the new type if present, returns None otherwise
+func Map[T1, T2 any](o Option[T1], f func(T1) T2) Option[T2] {
+	if !o.ok {
+		return None[T2]()
+	}
+	return Some(f(o.value))
+}
+
+// FlatMap only executes f if the Option holds a value, returns None otherwise
+func FlatMap[T1, T2 any](o Option[T1], f func(T1) Option[T2]) Option[T2] {
+	if !o.ok {
+		return None[T2]()
+	}
+	return f(o.value)
+}
+
+// MarshalJSON encodes None as null and Some as the encoding of the held value
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+// Scan implements the sql.Scanner interface. A NULL column is scanned as None
+func (o *Option[T]) Scan(src any) error {
+	var null sql.Null[T]
+	if err := null.Scan(src); err != nil {
+		return err
+	}
+
+	*o = New(null.V, null.Valid)
+	return nil
+}
+
+// Value implements the driver.Valuer interface. None is stored as NULL
+func (o Option[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: o.value, Valid: o.ok}.Value()
+}
@@ -0,0 +1,109 @@
+package option_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/monads/option"
+	"github.com/axelarnetwork/utils/monads/results"
+)
+
+func TestOption(t *testing.T) {
+	t.Run("constructors", func(t *testing.T) {
+		assert.True(t, option.Some(0).IsSome())
+		assert.True(t, option.None[int]().IsNone())
+		assert.Equal(t, option.Some("a"), option.New("a", true))
+		assert.Equal(t, option.None[string](), option.New("a", false))
+
+		value := 5
+		assert.Equal(t, option.Some(5), option.FromPtr(&value))
+		assert.Equal(t, option.None[int](), option.FromPtr[int](nil))
+
+		assert.Equal(t, option.Some(5), option.FromResult(results.FromOk(5)))
+		assert.Equal(t, option.None[int](), option.FromResult(results.FromErr[int](errors.New("some error"))))
+	})
+
+	t.Run("conversions", func(t *testing.T) {
+		assert.Equal(t, 5, funcs.MustOk(option.Some(5).Get()))
+		_, ok := option.None[int]().Get()
+		assert.False(t, ok)
+
+		assert.Equal(t, 5, *option.Some(5).Ptr())
+		assert.Nil(t, option.None[int]().Ptr())
+
+		assert.Equal(t, 5, option.Some(5).ToResult(errors.New("missing")).Ok())
+		assert.EqualError(t, option.None[int]().ToResult(errors.New("missing")).Err(), "missing")
+	})
+
+	t.Run("OrElse", func(t *testing.T) {
+		assert.Equal(t, 5, option.Some(5).OrElse(1))
+		assert.Equal(t, 1, option.None[int]().OrElse(1))
+
+		assert.Equal(t, 5, option.Some(5).OrElseGet(func() int { panic("should not be called") }))
+		assert.Equal(t, 1, option.None[int]().OrElseGet(func() int { return 1 }))
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		assert.Equal(t, option.Some("5"), option.Map(option.Some(5), strconv.Itoa))
+		assert.Equal(t, option.None[string](), option.Map(option.None[int](), strconv.Itoa))
+	})
+
+	t.Run("FlatMap", func(t *testing.T) {
+		parse := func(s string) option.Option[int] { return option.FromResult(results.New(strconv.Atoi(s))) }
+
+		assert.Equal(t, option.Some(5), option.FlatMap(option.Some("5"), parse))
+		assert.Equal(t, option.None[int](), option.FlatMap(option.Some("x"), parse))
+		assert.Equal(t, option.None[int](), option.FlatMap(option.None[string](), parse))
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		isEven := func(i int) bool { return i%2 == 0 }
+
+		assert.Equal(t, option.Some(4), option.Some(4).Filter(isEven))
+		assert.Equal(t, option.None[int](), option.Some(3).Filter(isEven))
+		assert.Equal(t, option.None[int](), option.None[int]().Filter(isEven))
+	})
+}
+
+func TestOption_JSON(t *testing.T) {
+	type payload struct {
+		Name  option.Option[string] `json:"name"`
+		Count option.Option[int]    `json:"count"`
+	}
+
+	bz, err := json.Marshal(payload{Name: option.Some("axelar"), Count: option.None[int]()})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"axelar","count":null}`, string(bz))
+
+	var p payload
+	assert.NoError(t, json.Unmarshal([]byte(`{"name":null,"count":0}`), &p))
+	assert.Equal(t, payload{Name: option.None[string](), Count: option.Some(0)}, p)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"count":"x"}`), &p))
+}
+
+func TestOption_SQL(t *testing.T) {
+	var o option.Option[int64]
+	assert.NoError(t, o.Scan(int64(5)))
+	assert.Equal(t, option.Some(int64(5)), o)
+
+	assert.NoError(t, o.Scan(nil))
+	assert.Equal(t, option.None[int64](), o)
+
+	var s option.Option[string]
+	assert.NoError(t, s.Scan([]byte("axelar")))
+	assert.Equal(t, option.Some("axelar"), s)
+
+	value, err := option.Some("axelar").Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "axelar", value)
+
+	value, err = option.None[string]().Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
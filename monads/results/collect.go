@@ -0,0 +1,52 @@
+package results
+
+import (
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// Collect turns a slice of Results into a Result of a slice. Returns the first encountered error if there is one
+func Collect[T any](source []Result[T]) Result[[]T] {
+	out := make([]T, 0, len(source))
+	for _, res := range source {
+		if res.err != nil {
+			return FromErr[[]T](res.err)
+		}
+		out = append(out, res.ok)
+	}
+
+	return FromOk(out)
+}
+
+// Partition splits a slice of Results into the values of successful Results and the errors of failed ones, preserving their order
+func Partition[T any](source []Result[T]) ([]T, []error) {
+	var (
+		oks  []T
+		errs []error
+	)
+
+	for _, res := range source {
+		if res.err != nil {
+			errs = append(errs, res.err)
+			continue
+		}
+		oks = append(oks, res.ok)
+	}
+
+	return oks, errs
+}
+
+// Zip combines two Results into a Result of a pair. Returns the first error if either Result holds one
+func Zip[A, B any](first Result[A], second Result[B]) Result[slices.Pair[A, B]] {
+	return ZipWith(first, second, func(a A, b B) slices.Pair[A, B] { return slices.Pair[A, B]{First: a, Second: b} })
+}
+
+// ZipWith combines the values of two Results with the given function. Returns the first error if either Result holds one
+func ZipWith[A, B, C any](first Result[A], second Result[B], f func(A, B) C) Result[C] {
+	if first.err != nil {
+		return FromErr[C](first.err)
+	}
+	if second.err != nil {
+		return FromErr[C](second.err)
+	}
+	return FromOk(f(first.ok, second.ok))
+}
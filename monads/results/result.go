@@ -1,5 +1,9 @@
 package results
 
+import (
+	"errors"
+)
+
 // Result wraps the idiomatic tuple of (value, error)
 type Result[T any] struct {
 	ok  T
@@ -57,3 +61,91 @@ func Try[T1, T2 any](res Result[T1], f func(T1) T2) Result[T2] {
 	}
 	return FromOk(f(res.Ok()))
 }
+
+// Get returns the idiomatic tuple of (value, error)
+func (res Result[T]) Get() (T, error) {
+	return res.ok, res.err
+}
+
+// IsOk returns true if the Result holds no error
+func (res Result[T]) IsOk() bool {
+	return res.err == nil
+}
+
+// IsErr returns true if the Result holds an error
+func (res Result[T]) IsErr() bool {
+	return res.err != nil
+}
+
+// Unwrap returns the value of the Result. Panics if the Result holds an error
+func (res Result[T]) Unwrap() T {
+	if res.err != nil {
+		panic(res.err)
+	}
+	return res.ok
+}
+
+// UnwrapOr returns the value of the Result, or the fallback if the Result holds an error
+func (res Result[T]) UnwrapOr(fallback T) T {
+	if res.err != nil {
+		return fallback
+	}
+	return res.ok
+}
+
+// MapErr transforms the error of the Result if there is one, returns the Result unchanged otherwise
+func (res Result[T]) MapErr(f func(error) error) Result[T] {
+	if res.err == nil {
+		return res
+	}
+	return FromErr[T](f(res.err))
+}
+
+// OrElse only executes f if the Result holds an error, returns the Result unchanged otherwise
+func (res Result[T]) OrElse(f func(error) Result[T]) Result[T] {
+	if res.err == nil {
+		return res
+	}
+	return f(res.err)
+}
+
+// Recover turns an error into a value by executing f, returns the Result unchanged if it holds no error
+func (res Result[T]) Recover(f func(error) T) Result[T] {
+	if res.err == nil {
+		return res
+	}
+	return FromOk(f(res.err))
+}
+
+// Tap executes f with the value of the Result if it holds no error and returns the Result unchanged
+func (res Result[T]) Tap(f func(T)) Result[T] {
+	if res.err == nil {
+		f(res.ok)
+	}
+	return res
+}
+
+// TapErr executes f with the error of the Result if there is one and returns the Result unchanged
+func (res Result[T]) TapErr(f func(error)) Result[T] {
+	if res.err != nil {
+		f(res.err)
+	}
+	return res
+}
+
+// Is reports whether the error of the Result matches the target, see errors.Is
+func (res Result[T]) Is(target error) bool {
+	return errors.Is(res.err, target)
+}
+
+// As returns the first error in the Result's error chain that matches the type E, see errors.As
+func As[E error, T any](res Result[T]) (E, bool) {
+	var target E
+	ok := errors.As(res.err, &target)
+	return target, ok
+}
+
+// Must returns the value of the Result and panics if it holds an error. It is the function form of Result.Unwrap
+func Must[T any](res Result[T]) T {
+	return res.Unwrap()
+}
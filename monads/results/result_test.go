@@ -2,12 +2,15 @@ package results_test
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/axelarnetwork/utils/funcs"
 	"github.com/axelarnetwork/utils/monads/results"
+	"github.com/axelarnetwork/utils/slices"
 )
 
 func TestResult(t *testing.T) {
@@ -39,6 +42,103 @@ func TestResult(t *testing.T) {
 		assert.Error(t, results.Try(unsuccessfulFunc("fail"), strconv.Itoa).Err())
 		assert.NoError(t, results.Try(successfulFunc(20), func(s string) bool { return s == "20" }).Err())
 	})
+
+	t.Run("Get", func(t *testing.T) {
+		assert.Equal(t, "5", funcs.Must(successfulFunc(5).Get()))
+		_, err := unsuccessfulFunc("fail").Get()
+		assert.Error(t, err)
+
+		assert.True(t, successfulFunc(5).IsOk())
+		assert.True(t, unsuccessfulFunc("fail").IsErr())
+	})
+
+	t.Run("Unwrap", func(t *testing.T) {
+		assert.Equal(t, "5", successfulFunc(5).Unwrap())
+		assert.Panics(t, func() { unsuccessfulFunc("fail").Unwrap() })
+
+		assert.Equal(t, "5", results.Must(successfulFunc(5)))
+		assert.Panics(t, func() { results.Must(unsuccessfulFunc("fail")) })
+
+		assert.Equal(t, "5", successfulFunc(5).UnwrapOr("1"))
+		assert.Equal(t, 1, unsuccessfulFunc("fail").UnwrapOr(1))
+	})
+
+	t.Run("MapErr", func(t *testing.T) {
+		wrap := func(err error) error { return fmt.Errorf("wrapped: %w", err) }
+
+		assert.EqualError(t, unsuccessfulFunc("fail").MapErr(wrap).Err(), "wrapped: some error")
+		assert.Equal(t, successfulFunc(5), successfulFunc(5).MapErr(wrap))
+	})
+
+	t.Run("OrElse", func(t *testing.T) {
+		fallback := func(error) results.Result[int] { return results.FromOk(1) }
+
+		assert.Equal(t, results.FromOk(1), unsuccessfulFunc("fail").OrElse(fallback))
+		assert.Equal(t, results.FromOk(5), results.FromOk(5).OrElse(fallback))
+		assert.Error(t, unsuccessfulFunc("fail").OrElse(unsuccessfulFallback).Err())
+	})
+
+	t.Run("Recover", func(t *testing.T) {
+		assert.Equal(t, results.FromOk(1), unsuccessfulFunc("fail").Recover(func(error) int { return 1 }))
+		assert.Equal(t, results.FromOk(5), results.FromOk(5).Recover(func(error) int { return 1 }))
+	})
+
+	t.Run("Tap", func(t *testing.T) {
+		var values []string
+		var errs []error
+
+		successfulFunc(5).Tap(func(s string) { values = append(values, s) }).TapErr(func(err error) { errs = append(errs, err) })
+		successfulFunc2("fail").Tap(func(r rune) { values = append(values, string(r)) })
+		unsuccessfulFunc("fail").Tap(func(int) { values = append(values, "unexpected") }).TapErr(func(err error) { errs = append(errs, err) })
+
+		assert.Equal(t, []string{"5", "f"}, values)
+		assert.Len(t, errs, 1)
+	})
+
+	t.Run("Is/As", func(t *testing.T) {
+		res := results.FromErr[int](fmt.Errorf("wrapped: %w", &strconv.NumError{Func: "Atoi", Num: "x", Err: strconv.ErrSyntax}))
+
+		assert.True(t, res.Is(strconv.ErrSyntax))
+		assert.False(t, res.Is(strconv.ErrRange))
+		assert.False(t, results.FromOk(5).Is(strconv.ErrSyntax))
+
+		numErr, ok := results.As[*strconv.NumError](res)
+		assert.True(t, ok)
+		assert.Equal(t, "x", numErr.Num)
+
+		_, ok = results.As[*strconv.NumError](unsuccessfulFunc("fail"))
+		assert.False(t, ok)
+	})
+}
+
+func TestCollect(t *testing.T) {
+	assert.Equal(t, results.FromOk([]string{"1", "2"}), results.Collect([]results.Result[string]{successfulFunc(1), successfulFunc(2)}))
+	assert.Equal(t, results.FromOk([]string{}), results.Collect([]results.Result[string]{}))
+
+	res := results.Collect([]results.Result[int]{results.FromOk(1), unsuccessfulFunc("fail"), results.FromErr[int](errors.New("other error"))})
+	assert.EqualError(t, res.Err(), "some error")
+	assert.Nil(t, res.Ok())
+}
+
+func TestPartition(t *testing.T) {
+	oks, errs := results.Partition([]results.Result[int]{results.FromOk(1), unsuccessfulFunc("fail"), results.FromOk(3)})
+	assert.Equal(t, []int{1, 3}, oks)
+	assert.Len(t, errs, 1)
+
+	oks, errs = results.Partition([]results.Result[int]{results.FromOk(1)})
+	assert.Equal(t, []int{1}, oks)
+	assert.Empty(t, errs)
+}
+
+func TestZip(t *testing.T) {
+	zipped := results.Zip(successfulFunc(1), results.FromOk(2))
+	assert.Equal(t, slices.Pair[string, int]{First: "1", Second: 2}, zipped.Ok())
+
+	assert.EqualError(t, results.Zip(unsuccessfulFunc("fail"), results.FromErr[int](errors.New("other error"))).Err(), "some error")
+	assert.EqualError(t, results.Zip(results.FromOk(1), results.FromErr[int](errors.New("other error"))).Err(), "other error")
+
+	sum := results.ZipWith(results.FromOk(1), results.FromOk(2), func(a, b int) int { return a + b })
+	assert.Equal(t, results.FromOk(3), sum)
 }
 
 func successfulFunc(i int) results.Result[string] {
@@ -52,3 +152,7 @@ func successfulFunc2(s string) results.Result[rune] {
 func unsuccessfulFunc(string) results.Result[int] {
 	return results.New(0, errors.New("some error"))
 }
+
+func unsuccessfulFallback(error) results.Result[int] {
+	return results.New(0, errors.New("fallback error"))
+}
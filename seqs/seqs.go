@@ -0,0 +1,89 @@
+// Package seqs provides lazy operators over iter.Seq and iter.Seq2 sequences. Elements are only computed when they are consumed,
+// so no intermediate slices are allocated.
+package seqs
+
+import "iter"
+
+// Map lazily maps a sequence of T to a sequence of S
+func Map[T, S any](seq iter.Seq[T], f func(T) S) iter.Seq[S] {
+	return func(yield func(S) bool) {
+		for x := range seq {
+			if !yield(f(x)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter lazily returns a sequence that only contains elements that match the predicate
+func Filter[T any](seq iter.Seq[T], predicate func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for x := range seq {
+			if predicate(x) && !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Take lazily returns a sequence of at most the first n elements. The source sequence is not advanced further once n elements have been yielded
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+		for x := range seq {
+			if !yield(x) {
+				return
+			}
+
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	}
+}
+
+// Chunk lazily groups the sequence into slices of the given size, only the last chunk can be smaller. Panics if size is not positive
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size <= 0 {
+		panic("chunk size must be positive")
+	}
+
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for x := range seq {
+			chunk = append(chunk, x)
+			if len(chunk) < size {
+				continue
+			}
+
+			if !yield(chunk) {
+				return
+			}
+			chunk = make([]T, 0, size)
+		}
+
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Zip lazily pairs up the elements of both sequences in order. The sequence ends when either of the source sequences ends
+func Zip[A, B any](first iter.Seq[A], second iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := iter.Pull(second)
+		defer stop()
+
+		for a := range first {
+			b, ok := next()
+			if !ok || !yield(a, b) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package seqs_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/seqs"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func naturals(yield func(int) bool) {
+	for i := 0; ; i++ {
+		if !yield(i) {
+			return
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	out := slices.FromSeq(seqs.Map(slices.Seq([]int{1, 2, 3}), strconv.Itoa))
+	assert.Equal(t, []string{"1", "2", "3"}, out)
+}
+
+func TestFilter(t *testing.T) {
+	out := slices.FromSeq(seqs.Filter(slices.Seq([]int{1, 2, 3, 4, 5}), func(i int) bool { return i%2 == 1 }))
+	assert.Equal(t, []int{1, 3, 5}, out)
+}
+
+func TestTake(t *testing.T) {
+	assert.Equal(t, []int{0, 1, 2}, slices.FromSeq(seqs.Take(naturals, 3)))
+	assert.Equal(t, []int{1, 2}, slices.FromSeq(seqs.Take(slices.Seq([]int{1, 2}), 3)))
+	assert.Empty(t, slices.FromSeq(seqs.Take(naturals, 0)))
+}
+
+func TestChunk(t *testing.T) {
+	assert.Equal(t, [][]int{{0, 1}, {2, 3}, {4}}, slices.FromSeq(seqs.Chunk(seqs.Take(naturals, 5), 2)))
+	assert.Equal(t, [][]int{{0, 1, 2}}, slices.FromSeq(seqs.Chunk(seqs.Take(naturals, 3), 3)))
+	assert.Empty(t, slices.FromSeq(seqs.Chunk(seqs.Take(naturals, 0), 3)))
+	assert.Panics(t, func() { seqs.Chunk(naturals, 0) })
+}
+
+func TestZip(t *testing.T) {
+	var letters []string
+	var numbers []int
+	for i, s := range seqs.Zip(naturals, slices.Seq([]string{"a", "b", "c"})) {
+		numbers = append(numbers, i)
+		letters = append(letters, s)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, numbers)
+	assert.Equal(t, []string{"a", "b", "c"}, letters)
+}
+
+func TestLazy(t *testing.T) {
+	calls := 0
+	square := func(i int) int {
+		calls++
+		return i * i
+	}
+
+	out := slices.FromSeq(seqs.Take(seqs.Filter(seqs.Map(naturals, square), func(i int) bool { return i%2 == 0 }), 3))
+	assert.Equal(t, []int{0, 4, 16}, out)
+	assert.Equal(t, 5, calls)
+}
@@ -0,0 +1,213 @@
+// Package sets provides a generic set type with set-theoretic operations.
+package sets
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Set is an unordered collection of distinct elements
+type Set[T comparable] map[T]struct{}
+
+// New returns a new set containing the given items
+func New[T comparable](items ...T) Set[T] {
+	s := make(Set[T], len(items))
+	s.Add(items...)
+
+	return s
+}
+
+// Add inserts the given items into the set
+func (s Set[T]) Add(items ...T) {
+	for _, item := range items {
+		s[item] = struct{}{}
+	}
+}
+
+// Remove deletes the given items from the set
+func (s Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s, item)
+	}
+}
+
+// Has returns true if the given item is included in the set
+func (s Set[T]) Has(item T) bool {
+	_, ok := s[item]
+	return ok
+}
+
+// Len returns the number of elements in the set
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Clone returns a shallow copy of the set
+func (s Set[T]) Clone() Set[T] {
+	out := make(Set[T], len(s))
+	for item := range s {
+		out[item] = struct{}{}
+	}
+
+	return out
+}
+
+// ToSlice returns the elements of the set in unspecified order. Use Sorted or SortedFunc for a deterministic order
+func (s Set[T]) ToSlice() []T {
+	out := make([]T, 0, len(s))
+	for item := range s {
+		out = append(out, item)
+	}
+
+	return out
+}
+
+// Union returns a new set with the elements that are in either set
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	out := s.Clone()
+	for item := range other {
+		out[item] = struct{}{}
+	}
+
+	return out
+}
+
+// Intersection returns a new set with the elements that are in both sets
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	smaller, larger := s, other
+	if len(smaller) > len(larger) {
+		smaller, larger = larger, smaller
+	}
+
+	out := make(Set[T])
+	for item := range smaller {
+		if larger.Has(item) {
+			out[item] = struct{}{}
+		}
+	}
+
+	return out
+}
+
+// Difference returns a new set with the elements of s that are not in other
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	out := make(Set[T])
+	for item := range s {
+		if !other.Has(item) {
+			out[item] = struct{}{}
+		}
+	}
+
+	return out
+}
+
+// SymmetricDifference returns a new set with the elements that are in exactly one of the sets
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	out := s.Difference(other)
+	for item := range other {
+		if !s.Has(item) {
+			out[item] = struct{}{}
+		}
+	}
+
+	return out
+}
+
+// IsSubsetOf returns true if all elements of s are in other
+func (s Set[T]) IsSubsetOf(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+
+	for item := range s {
+		if !other.Has(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSupersetOf returns true if all elements of other are in s
+func (s Set[T]) IsSupersetOf(other Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// Equal returns true if both sets contain the same elements
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubsetOf(other)
+}
+
+// MarshalJSON encodes the set as a JSON array in deterministic order. Elements of a type with an ordered underlying type
+// (integers, floats and strings) are sorted by value, all other elements are sorted byte-wise by their JSON encoding
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	if compare, ok := orderedCompare[T](); ok {
+		items := s.ToSlice()
+		sort.Slice(items, func(i, j int) bool { return compare(items[i], items[j]) < 0 })
+
+		return json.Marshal(items)
+	}
+
+	encoded := make([]json.RawMessage, 0, len(s))
+	for item := range s {
+		bz, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded = append(encoded, bz)
+	}
+
+	sort.Slice(encoded, func(i, j int) bool { return bytes.Compare(encoded[i], encoded[j]) < 0 })
+
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON decodes a JSON array into the set. Duplicate elements are merged
+func (s *Set[T]) UnmarshalJSON(bz []byte) error {
+	var items []T
+	if err := json.Unmarshal(bz, &items); err != nil {
+		return err
+	}
+
+	*s = New(items...)
+	return nil
+}
+
+// Sorted returns the elements of the set in ascending order
+func Sorted[T constraints.Ordered](s Set[T]) []T {
+	out := s.ToSlice()
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+
+	return out
+}
+
+// SortedFunc returns the elements of the set ordered by the given less function
+func SortedFunc[T comparable](s Set[T], less func(a, b T) bool) []T {
+	out := s.ToSlice()
+	sort.Slice(out, func(i, j int) bool { return less(out[i], out[j]) })
+
+	return out
+}
+
+// orderedCompare returns a comparison function for T if its underlying type is ordered, so defined types like `type Height uint64` are included.
+// Interface types are excluded, because their dynamic types might not be comparable with each other
+func orderedCompare[T comparable]() (func(a, b T) int, bool) {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b T) int { return cmp.Compare(reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int()) }, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b T) int { return cmp.Compare(reflect.ValueOf(a).Uint(), reflect.ValueOf(b).Uint()) }, true
+	case reflect.Float32, reflect.Float64:
+		return func(a, b T) int { return cmp.Compare(reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float()) }, true
+	case reflect.String:
+		return func(a, b T) int { return cmp.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String()) }, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,103 @@
+package sets_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/utils/sets"
+)
+
+func TestSet(t *testing.T) {
+	s := sets.New(1, 2, 2, 3)
+	assert.Equal(t, 3, s.Len())
+	assert.True(t, s.Has(2))
+
+	s.Add(4)
+	s.Remove(1, 5)
+	assert.Equal(t, []int{2, 3, 4}, sets.Sorted(s))
+
+	clone := s.Clone()
+	clone.Add(10)
+	assert.False(t, s.Has(10))
+	assert.ElementsMatch(t, []int{2, 3, 4, 10}, clone.ToSlice())
+}
+
+func TestSetOperations(t *testing.T) {
+	a := sets.New(1, 2, 3, 4)
+	b := sets.New(3, 4, 5)
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, sets.Sorted(a.Union(b)))
+	assert.Equal(t, []int{3, 4}, sets.Sorted(a.Intersection(b)))
+	assert.Equal(t, []int{1, 2}, sets.Sorted(a.Difference(b)))
+	assert.Equal(t, []int{1, 2, 5}, sets.Sorted(a.SymmetricDifference(b)))
+
+	// operands are not modified
+	assert.Equal(t, []int{1, 2, 3, 4}, sets.Sorted(a))
+	assert.Equal(t, []int{3, 4, 5}, sets.Sorted(b))
+}
+
+func TestSubset(t *testing.T) {
+	a := sets.New(1, 2, 3)
+
+	assert.True(t, sets.New(1, 3).IsSubsetOf(a))
+	assert.True(t, sets.New[int]().IsSubsetOf(a))
+	assert.False(t, sets.New(1, 4).IsSubsetOf(a))
+	assert.True(t, a.IsSupersetOf(sets.New(2)))
+	assert.True(t, a.Equal(sets.New(3, 2, 1)))
+	assert.False(t, a.Equal(sets.New(1, 2, 4)))
+}
+
+func TestSortedFunc(t *testing.T) {
+	s := sets.New("ccc", "a", "bb")
+	assert.Equal(t, []string{"ccc", "bb", "a"}, sets.SortedFunc(s, func(a, b string) bool { return len(a) > len(b) }))
+}
+
+func TestJSON(t *testing.T) {
+	s := sets.New("osmosis", "ethereum", "axelar")
+
+	bz, err := json.Marshal(s)
+	require.NoError(t, err)
+	assert.Equal(t, `["axelar","ethereum","osmosis"]`, string(bz))
+
+	var decoded sets.Set[string]
+	require.NoError(t, json.Unmarshal([]byte(`["b","a","b"]`), &decoded))
+	assert.Equal(t, sets.New("a", "b"), decoded)
+
+	type wrapper struct {
+		Chains sets.Set[string]
+	}
+	bz, err = json.Marshal(wrapper{Chains: s})
+	require.NoError(t, err)
+
+	var w wrapper
+	require.NoError(t, json.Unmarshal(bz, &w))
+	assert.True(t, s.Equal(w.Chains))
+}
+
+func TestJSON_Order(t *testing.T) {
+	bz, err := json.Marshal(sets.New(10, 2, -1))
+	require.NoError(t, err)
+	assert.Equal(t, `[-1,2,10]`, string(bz))
+
+	type height uint64
+	bz, err = json.Marshal(sets.New[height](100, 9))
+	require.NoError(t, err)
+	assert.Equal(t, `[9,100]`, string(bz))
+
+	bz, err = json.Marshal(sets.New(2.5, -0.5, 10))
+	require.NoError(t, err)
+	assert.Equal(t, `[-0.5,2.5,10]`, string(bz))
+
+	// elements without an ordered type fall back to byte-wise order of their encoding
+	type chain struct{ ID int }
+	bz, err = json.Marshal(sets.New(chain{ID: 10}, chain{ID: 2}))
+	require.NoError(t, err)
+	assert.Equal(t, `[{"ID":10},{"ID":2}]`, string(bz))
+
+	bz, err = json.Marshal(sets.New[any](10, "a", 2))
+	require.NoError(t, err)
+	assert.Equal(t, `["a",10,2]`, string(bz))
+}
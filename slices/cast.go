@@ -0,0 +1,93 @@
+package slices
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// CastAs asserts each element of the slice to type T2, which is usually an interface or the concrete type behind an interface.
+// Elements that do not hold a value of type T2, including nil interfaces, get filtered out. No type conversions are performed
+func CastAs[T1 any, T2 any](source []T1) []T2 {
+	out := make([]T2, 0, len(source))
+
+	for i := range source {
+		if x, ok := any(source[i]).(T2); ok {
+			out = append(out, x)
+		}
+	}
+
+	return out
+}
+
+// Convert converts each element of the slice from numeric type T1 to numeric type T2.
+// Returns an IndexedError for the first element that cannot be represented exactly in T2, e.g. due to overflow, a sign change or a fractional part
+func Convert[T1, T2 Number](source []T1) ([]T2, error) {
+	out := make([]T2, len(source))
+	from, to := rangeOf[T1](), rangeOf[T2]()
+
+	for i := range source {
+		x := source[i]
+		y, ok := convert[T1, T2](x, from, to)
+
+		if !ok {
+			return nil, IndexedError{Index: i, Err: fmt.Errorf("value %v cannot be represented as %T", x, y)}
+		}
+
+		out[i] = y
+	}
+
+	return out, nil
+}
+
+// convert converts x to T2 and returns false if the conversion is not exact
+func convert[T1, T2 Number](x T1, from, to numberRange) (T2, bool) {
+	// converting a float outside the range of an integer type is implementation-specific,
+	// so the bounds must be checked before the conversion and before the round trip
+	if from.isFloat && !to.contains(float64(x)) {
+		return 0, false
+	}
+
+	y := T2(x)
+	if to.isFloat && !from.contains(float64(y)) {
+		return y, false
+	}
+
+	return y, isLossless(x, y)
+}
+
+// isLossless returns true if y is the exact representation of x after a conversion between numeric types
+func isLossless[T1, T2 Number](x T1, y T2) bool {
+	// NaN never equals itself, so it can only be preserved by a conversion to another float type, where it stays NaN
+	if x != x {
+		return y != y
+	}
+
+	// a lossless conversion must survive the round trip and keep the sign
+	return T1(y) == x && (x < 0) == (y < 0)
+}
+
+// numberRange describes the values a numeric type can hold. Integer types hold the values in [lower, upper)
+type numberRange struct {
+	isFloat      bool
+	lower, upper float64
+}
+
+// rangeOf returns the range of values the numeric type T can hold
+func rangeOf[T Number]() numberRange {
+	t := reflect.TypeFor[T]()
+
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return numberRange{isFloat: true}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return numberRange{lower: 0, upper: math.Ldexp(1, t.Bits())}
+	default:
+		return numberRange{lower: -math.Ldexp(1, t.Bits()-1), upper: math.Ldexp(1, t.Bits()-1)}
+	}
+}
+
+// contains returns true if a float with the given value can be converted to the type without overflow. NaN is only contained in float types
+func (r numberRange) contains(f float64) bool {
+	return r.isFloat || (r.lower <= f && f < r.upper)
+}
@@ -0,0 +1,137 @@
+package slices_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func TestCastAs(t *testing.T) {
+	source := []any{1, "a", nil, 2, fmt.Errorf("b"), int64(3)}
+
+	assert.Equal(t, []int{1, 2}, slices.CastAs[any, int](source))
+	assert.Equal(t, []fmt.Stringer{}, slices.CastAs[any, fmt.Stringer](source))
+	assert.Len(t, slices.CastAs[any, error](source), 1)
+
+	// must not panic on nil interface elements
+	assert.Empty(t, slices.CastAs[error, fmt.Stringer]([]error{nil, nil}))
+
+	// concrete to interface
+	assert.Len(t, slices.CastAs[derived, any]([]derived{"a", "b"}), 2)
+}
+
+func TestConvert(t *testing.T) {
+	out, err := slices.Convert[int64, int8]([]int64{1, -2, 127})
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{1, -2, 127}, out)
+
+	_, err = slices.Convert[int64, int8]([]int64{1, 128})
+	assert.ErrorContains(t, err, "element 1")
+
+	_, err = slices.Convert[int, uint]([]int{1, -1})
+	assert.ErrorContains(t, err, "element 1")
+
+	_, err = slices.Convert[uint64, int64]([]uint64{math.MaxUint64})
+	assert.Error(t, err)
+
+	floats, err := slices.Convert[int, float64]([]int{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 2}, floats)
+
+	_, err = slices.Convert[float64, int]([]float64{1, 2.5})
+	assert.Error(t, err)
+
+	_, err = slices.Convert[float64, int]([]float64{math.NaN()})
+	assert.Error(t, err)
+
+	_, err = slices.Convert[float64, int]([]float64{math.Inf(1)})
+	assert.Error(t, err)
+}
+
+func TestConvert_FloatBounds(t *testing.T) {
+	// MaxInt64 rounds up to 2^63 as float64, which is out of range for int64 on the way back
+	_, err := slices.Convert[int64, float64]([]int64{math.MaxInt64})
+	assert.ErrorContains(t, err, "element 0")
+
+	_, err = slices.Convert[uint64, float32]([]uint64{math.MaxUint64})
+	assert.ErrorContains(t, err, "element 0")
+
+	bounds, err := slices.Convert[int64, float64]([]int64{math.MinInt64, 1 << 62})
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{-(1 << 63), 1 << 62}, bounds)
+
+	_, err = slices.Convert[float64, int64]([]float64{0, 1 << 63})
+	assert.ErrorContains(t, err, "element 1")
+
+	_, err = slices.Convert[float64, int64]([]float64{-(1 << 63) * 2})
+	assert.ErrorContains(t, err, "element 0")
+
+	_, err = slices.Convert[float64, uint8]([]float64{255, 256})
+	assert.ErrorContains(t, err, "element 1")
+
+	_, err = slices.Convert[float32, int8]([]float32{-128, -129})
+	assert.ErrorContains(t, err, "element 1")
+
+	ints, err := slices.Convert[float64, int64]([]float64{-(1 << 63), 1 << 62})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{math.MinInt64, 1 << 62}, ints)
+}
+
+func TestConvert_FloatSpecialValues(t *testing.T) {
+	special := []float64{math.NaN(), math.Inf(1), math.Inf(-1)}
+
+	same, err := slices.Convert[float64, float64](special)
+	assert.NoError(t, err)
+	assert.True(t, math.IsNaN(same[0]))
+	assert.Equal(t, special[1:], same[1:])
+
+	narrowed, err := slices.Convert[float64, float32](special)
+	assert.NoError(t, err)
+	assert.True(t, math.IsNaN(float64(narrowed[0])))
+	assert.Equal(t, []float32{float32(math.Inf(1)), float32(math.Inf(-1))}, narrowed[1:])
+
+	widened, err := slices.Convert[float32, float64]([]float32{float32(math.NaN()), float32(math.Inf(-1))})
+	assert.NoError(t, err)
+	assert.True(t, math.IsNaN(widened[0]))
+	assert.Equal(t, math.Inf(-1), widened[1])
+
+	// finite values that overflow to infinity are not exact
+	_, err = slices.Convert[float64, float32]([]float64{math.MaxFloat64})
+	assert.ErrorContains(t, err, "element 0")
+}
+
+func BenchmarkCast(b *testing.B) {
+	source := slices.Expand(func(i int) any { return i }, 1000)
+
+	b.Run("reflection", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			slices.TryCast[any, int](source)
+		}
+	})
+
+	b.Run("CastAs", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			slices.CastAs[any, int](source)
+		}
+	})
+}
+
+func BenchmarkConvert(b *testing.B) {
+	source := slices.Expand(func(i int) int64 { return int64(i % 100) }, 1000)
+
+	b.Run("reflection", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			slices.TryCast[int64, int8](source)
+		}
+	})
+
+	b.Run("Convert", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = slices.Convert[int64, int8](source)
+		}
+	})
+}
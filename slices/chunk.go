@@ -0,0 +1,83 @@
+package slices
+
+// Chunk splits the slice into consecutive chunks of the given size. Only the last chunk can be smaller if the slice length is not a multiple of size.
+// Chunks are sub-slices sharing the source's backing array, but their capacity is capped so appending to a chunk never overwrites the next one.
+// Panics if size is not positive.
+func Chunk[T any](source []T, size int) [][]T {
+	if size <= 0 {
+		panic("chunk size must be positive")
+	}
+
+	out := make([][]T, 0, (len(source)+size-1)/size)
+	for start := 0; start < len(source); start += size {
+		end := min(start+size, len(source))
+		out = append(out, source[start:end:end])
+	}
+
+	return out
+}
+
+// Partition splits the slice into the elements that match the predicate and those that do not, preserving their order
+func Partition[T any](source []T, predicate func(T) bool) (matched []T, unmatched []T) {
+	matched = make([]T, 0, len(source))
+	unmatched = make([]T, 0, len(source))
+
+	for i := range source {
+		if predicate(source[i]) {
+			matched = append(matched, source[i])
+		} else {
+			unmatched = append(unmatched, source[i])
+		}
+	}
+
+	return matched, unmatched
+}
+
+// SlidingWindow returns all windows of n consecutive elements, e.g. [1 2 3 4] with n=2 results in [[1 2] [2 3] [3 4]].
+// Returns no windows if the slice is shorter than n. Windows are sub-slices sharing the source's backing array with capped capacity.
+// Panics if n is not positive.
+func SlidingWindow[T any](source []T, n int) [][]T {
+	if n <= 0 {
+		panic("window size must be positive")
+	}
+
+	if len(source) < n {
+		return [][]T{}
+	}
+
+	out := make([][]T, 0, len(source)-n+1)
+	for start := 0; start+n <= len(source); start++ {
+		out = append(out, source[start:start+n:start+n])
+	}
+
+	return out
+}
+
+// SplitAt splits the slice into the elements before index i and the elements from index i onwards.
+// The index is clamped to the bounds of the slice. Both parts share the source's backing array, the capacity of the first part is capped.
+func SplitAt[T any](source []T, i int) ([]T, []T) {
+	i = max(0, min(i, len(source)))
+
+	return source[:i:i], source[i:]
+}
+
+// Interleave merges the given slices by alternately taking one element from each, e.g. [1 2 3], [a b] results in [1 a 2 b 3].
+// Once a slice is exhausted, the remaining slices continue to alternate.
+func Interleave[T any](sources ...[]T) []T {
+	var l, longest int
+	for i := range sources {
+		l += len(sources[i])
+		longest = max(longest, len(sources[i]))
+	}
+
+	out := make([]T, 0, l)
+	for j := 0; j < longest; j++ {
+		for i := range sources {
+			if j < len(sources[i]) {
+				out = append(out, sources[i][j])
+			}
+		}
+	}
+
+	return out
+}
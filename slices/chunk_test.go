@@ -0,0 +1,66 @@
+package slices_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func TestChunk(t *testing.T) {
+	source := []int{1, 2, 3, 4, 5}
+
+	chunks := slices.Chunk(source, 2)
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, chunks)
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, slices.Chunk(source, 10))
+	assert.Empty(t, slices.Chunk([]int{}, 3))
+	assert.Panics(t, func() { slices.Chunk(source, 0) })
+
+	// chunks share memory with the source, but appending does not affect neighbours
+	chunks[0][0] = 10
+	assert.Equal(t, 10, source[0])
+	_ = append(chunks[0], 99)
+	assert.Equal(t, 3, source[2])
+}
+
+func TestPartition(t *testing.T) {
+	even, odd := slices.Partition([]int{1, 2, 3, 4, 5}, func(i int) bool { return i%2 == 0 })
+	assert.Equal(t, []int{2, 4}, even)
+	assert.Equal(t, []int{1, 3, 5}, odd)
+
+	matched, unmatched := slices.Partition([]int{}, func(i int) bool { return true })
+	assert.Empty(t, matched)
+	assert.Empty(t, unmatched)
+}
+
+func TestSlidingWindow(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}}, slices.SlidingWindow([]int{1, 2, 3, 4}, 3))
+	assert.Equal(t, [][]int{{1}, {2}}, slices.SlidingWindow([]int{1, 2}, 1))
+	assert.Empty(t, slices.SlidingWindow([]int{1, 2}, 3))
+	assert.Panics(t, func() { slices.SlidingWindow([]int{1, 2}, 0) })
+}
+
+func TestSplitAt(t *testing.T) {
+	source := []int{1, 2, 3, 4}
+
+	left, right := slices.SplitAt(source, 1)
+	assert.Equal(t, []int{1}, left)
+	assert.Equal(t, []int{2, 3, 4}, right)
+
+	_ = append(left, 10)
+	assert.Equal(t, 2, source[1])
+
+	left, right = slices.SplitAt(source, 10)
+	assert.Equal(t, source, left)
+	assert.Empty(t, right)
+
+	left, right = slices.SplitAt(source, -1)
+	assert.Empty(t, left)
+	assert.Equal(t, source, right)
+}
+
+func TestInterleave(t *testing.T) {
+	assert.Equal(t, []int{1, 10, 100, 2, 20, 3}, slices.Interleave([]int{1, 2, 3}, []int{10, 20}, []int{100}))
+	assert.Empty(t, slices.Interleave[int]())
+}
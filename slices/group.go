@@ -0,0 +1,83 @@
+package slices
+
+import "golang.org/x/exp/constraints"
+
+// Number is a constraint for all integer and float types
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Group holds all elements that share the same key
+type Group[K comparable, T any] struct {
+	Key   K
+	Items []T
+}
+
+// GroupByOrdered groups the elements by the given key like GroupBy, but returns the groups in the order in which their keys first appear
+func GroupByOrdered[T any, K comparable](source []T, fn func(T) K) []Group[K, T] {
+	positions := make(map[K]int)
+	var groups []Group[K, T]
+
+	for _, s := range source {
+		k := fn(s)
+
+		pos, ok := positions[k]
+		if !ok {
+			pos = len(groups)
+			positions[k] = pos
+			groups = append(groups, Group[K, T]{Key: k})
+		}
+
+		groups[pos].Items = append(groups[pos].Items, s)
+	}
+
+	return groups
+}
+
+// GroupByReduce groups the elements by the given key and reduces each group to a single value according to the given function.
+// The initial function is called once per group, so reference types like slices or maps are not shared between groups
+func GroupByReduce[T any, K comparable, S any](source []T, fn func(T) K, initial func() S, f func(current S, element T) S) map[K]S {
+	results := make(map[K]S)
+
+	for _, s := range source {
+		k := fn(s)
+
+		current, ok := results[k]
+		if !ok {
+			current = initial()
+		}
+
+		results[k] = f(current, s)
+	}
+
+	return results
+}
+
+// CountBy returns the number of elements for each key
+func CountBy[T any, K comparable](source []T, fn func(T) K) map[K]int {
+	return GroupByReduce(source, fn, func() int { return 0 }, func(count int, _ T) int { return count + 1 })
+}
+
+// SumBy returns the sum of the values of all elements for each key
+func SumBy[T any, K comparable, N Number](source []T, fn func(T) K, value func(T) N) map[K]N {
+	return GroupByReduce(source, fn, func() N { return 0 }, func(sum N, s T) N { return sum + value(s) })
+}
+
+// Frequencies returns how often each distinct element occurs in the slice
+func Frequencies[T comparable](source []T) map[T]int {
+	return CountBy(source, func(s T) T { return s })
+}
+
+// IndexBy returns a map from each key to the index of the first element with that key
+func IndexBy[T any, K comparable](source []T, fn func(T) K) map[K]int {
+	results := make(map[K]int)
+
+	for i := range source {
+		k := fn(source[i])
+		if _, ok := results[k]; !ok {
+			results[k] = i
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,53 @@
+package slices_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/slices"
+)
+
+type vote struct {
+	chain string
+	power int64
+}
+
+func chain(v vote) string { return v.chain }
+
+var votes = []vote{{"ethereum", 10}, {"osmosis", 5}, {"ethereum", 3}, {"avalanche", 1}, {"osmosis", 2}}
+
+func TestGroupByOrdered(t *testing.T) {
+	assert.Equal(t, []slices.Group[string, vote]{
+		{Key: "ethereum", Items: []vote{{"ethereum", 10}, {"ethereum", 3}}},
+		{Key: "osmosis", Items: []vote{{"osmosis", 5}, {"osmosis", 2}}},
+		{Key: "avalanche", Items: []vote{{"avalanche", 1}}},
+	}, slices.GroupByOrdered(votes, chain))
+
+	assert.Empty(t, slices.GroupByOrdered([]vote{}, chain))
+}
+
+func TestGroupByReduce(t *testing.T) {
+	maxPower := slices.GroupByReduce(votes, chain, func() int64 { return 0 }, func(current int64, v vote) int64 { return max(current, v.power) })
+	assert.Equal(t, map[string]int64{"ethereum": 10, "osmosis": 5, "avalanche": 1}, maxPower)
+
+	powers := slices.GroupByReduce(votes, chain, func() []int64 { return make([]int64, 0, len(votes)) }, func(current []int64, v vote) []int64 { return append(current, v.power) })
+	assert.Equal(t, []int64{10, 3}, powers["ethereum"])
+	assert.Equal(t, []int64{1}, powers["avalanche"])
+}
+
+func TestCountBy(t *testing.T) {
+	assert.Equal(t, map[string]int{"ethereum": 2, "osmosis": 2, "avalanche": 1}, slices.CountBy(votes, chain))
+}
+
+func TestSumBy(t *testing.T) {
+	assert.Equal(t, map[string]int64{"ethereum": 13, "osmosis": 7, "avalanche": 1}, slices.SumBy(votes, chain, func(v vote) int64 { return v.power }))
+}
+
+func TestFrequencies(t *testing.T) {
+	assert.Equal(t, map[string]int{"a": 3, "b": 1, "c": 2}, slices.Frequencies([]string{"a", "c", "a", "b", "c", "a"}))
+}
+
+func TestIndexBy(t *testing.T) {
+	assert.Equal(t, map[string]int{"ethereum": 0, "osmosis": 1, "avalanche": 3}, slices.IndexBy(votes, chain))
+}
@@ -0,0 +1,122 @@
+package slices
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-errors/errors"
+	"golang.org/x/sync/semaphore"
+)
+
+// ParallelMap maps a slice of T to a slice of S, running at most `workers` calls of f concurrently. A non-positive number of workers means no limit.
+// The output preserves the order of the source slice. On the first error or panic, the context passed to f is cancelled,
+// no further calls are started, and the error is returned as an IndexedError.
+func ParallelMap[T, S any](ctx context.Context, source []T, f func(context.Context, T) (S, error), workers int) ([]S, error) {
+	out := make([]S, len(source))
+
+	err := parallel(ctx, len(source), workers, func(ctx context.Context, i int) error {
+		s, err := f(ctx, source[i])
+		if err != nil {
+			return err
+		}
+
+		out[i] = s
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ParallelForEach performs the given function on every element of the slice, running at most `workers` calls concurrently. A non-positive number of workers means no limit.
+// On the first error or panic, the context passed to f is cancelled, no further calls are started, and the error is returned as an IndexedError.
+func ParallelForEach[T any](ctx context.Context, source []T, f func(context.Context, T) error, workers int) error {
+	return parallel(ctx, len(source), workers, func(ctx context.Context, i int) error {
+		return f(ctx, source[i])
+	})
+}
+
+// ParallelFilter returns a new slice that only contains elements that match the predicate, running at most `workers` predicate calls concurrently.
+// A non-positive number of workers means no limit. The output preserves the order of the source slice. On the first error or panic,
+// the context passed to the predicate is cancelled, no further calls are started, and the error is returned as an IndexedError.
+func ParallelFilter[T any](ctx context.Context, source []T, predicate func(context.Context, T) (bool, error), workers int) ([]T, error) {
+	matches, err := ParallelMap(ctx, source, predicate, workers)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]T, 0, len(source))
+	for i := range source {
+		if matches[i] {
+			out = append(out, source[i])
+		}
+	}
+
+	return out, nil
+}
+
+// parallel calls f for all indices in [0, n) with bounded concurrency and returns the first encountered error
+func parallel(ctx context.Context, n int, workers int, f func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var sem *semaphore.Weighted
+	if workers > 0 {
+		sem = semaphore.NewWeighted(int64(workers))
+	}
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	for i := 0; i < n; i++ {
+		if err := acquire(ctx, sem); err != nil {
+			fail(err)
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer release(sem)
+			defer func() {
+				if r := recover(); r != nil {
+					fail(IndexedError{Index: i, Err: fmt.Errorf("function panicked: %s\n%s", r, errors.Wrap(r, 1).Stack())})
+				}
+			}()
+
+			if err := f(ctx, i); err != nil {
+				fail(IndexedError{Index: i, Err: err})
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
+
+func acquire(ctx context.Context, sem *semaphore.Weighted) error {
+	if sem != nil {
+		return sem.Acquire(ctx, 1)
+	}
+	return ctx.Err()
+}
+
+func release(sem *semaphore.Weighted) {
+	if sem != nil {
+		sem.Release(1)
+	}
+}
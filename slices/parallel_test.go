@@ -0,0 +1,102 @@
+package slices_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func TestParallelMap(t *testing.T) {
+	source := slices.Expand(func(i int) int { return i }, 100)
+
+	var running, maxRunning atomic.Int64
+	out, err := slices.ParallelMap(context.Background(), source, func(_ context.Context, i int) (string, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+		return strconv.Itoa(i), nil
+	}, 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, slices.Map(source, strconv.Itoa), out)
+	assert.LessOrEqual(t, maxRunning.Load(), int64(5))
+}
+
+func TestParallelMap_Error(t *testing.T) {
+	source := slices.Expand(func(i int) int { return i }, 100)
+
+	var calls atomic.Int64
+	_, err := slices.ParallelMap(context.Background(), source, func(ctx context.Context, i int) (int, error) {
+		calls.Add(1)
+		if i == 3 {
+			return 0, errors.New("invalid signature")
+		}
+
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}, 4)
+
+	var indexedErr slices.IndexedError
+	assert.ErrorAs(t, err, &indexedErr)
+	assert.Equal(t, 3, indexedErr.Index)
+	assert.EqualError(t, indexedErr.Err, "invalid signature")
+	assert.Less(t, calls.Load(), int64(100))
+}
+
+func TestParallelMap_Panic(t *testing.T) {
+	_, err := slices.ParallelMap(context.Background(), []int{1, 2, 3}, func(_ context.Context, i int) (int, error) {
+		if i == 2 {
+			panic("unexpected value")
+		}
+		return i, nil
+	}, 0)
+
+	assert.ErrorContains(t, err, "element 1: function panicked: unexpected value")
+}
+
+func TestParallelMap_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := slices.ParallelMap(ctx, []int{1, 2, 3}, func(_ context.Context, i int) (int, error) { return i, nil }, 1)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestParallelForEach(t *testing.T) {
+	var total atomic.Int64
+	err := slices.ParallelForEach(context.Background(), []int64{1, 2, 3, 4}, func(_ context.Context, i int64) error {
+		total.Add(i)
+		return nil
+	}, 2)
+
+	assert.NoError(t, err)
+	assert.EqualValues(t, 10, total.Load())
+
+	err = slices.ParallelForEach(context.Background(), []int64{1, 2, 3, 4}, func(_ context.Context, i int64) error {
+		return errors.New("failed")
+	}, 1)
+	assert.ErrorContains(t, err, "element 0")
+}
+
+func TestParallelFilter(t *testing.T) {
+	source := slices.Expand(func(i int) int { return i }, 20)
+
+	out, err := slices.ParallelFilter(context.Background(), source, func(_ context.Context, i int) (bool, error) { return i%3 == 0, nil }, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 3, 6, 9, 12, 15, 18}, out)
+}
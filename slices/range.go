@@ -0,0 +1,107 @@
+package slices
+
+import (
+	"math/big"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/axelarnetwork/utils/internal/ranges"
+)
+
+var oneBig = big.NewInt(1)
+
+// RangeStep returns a slice that contains all values from `from` to `to` with the given step size.
+// Counts down if `from` is greater than `to`. `to` is included if it is reached exactly. Panics if step is not positive.
+func RangeStep[T constraints.Integer](from, to, step T) []T {
+	if step <= 0 {
+		panic("step must be positive")
+	}
+
+	out := []T{from}
+	for i, ok := ranges.StepTowards(from, to, step); ok; i, ok = ranges.StepTowards(i, to, step) {
+		out = append(out, i)
+	}
+
+	return out
+}
+
+// RangeBigStep returns a slice that contains all values from `from` to `to` with the given step size.
+// Counts down if `from` is greater than `to`. `to` is included if it is reached exactly. Panics if step is not positive.
+func RangeBigStep(from, to, step *big.Int) []*big.Int {
+	if step.Sign() <= 0 {
+		panic("step must be positive")
+	}
+
+	delta := step
+	if from.Cmp(to) > 0 {
+		delta = (&big.Int{}).Neg(step)
+	}
+
+	var out []*big.Int
+	for i := (&big.Int{}).Set(from); isBetween(i, from, to); i = (&big.Int{}).Add(i, delta) {
+		out = append(out, i)
+	}
+
+	return out
+}
+
+// RangeChunks returns a slice of inclusive [from, to] sub-ranges that cover all values from `from` to `to`.
+// Each sub-range contains at most maxSize values, only the last one can be smaller.
+// Sub-ranges count down if `from` is greater than `to`, e.g. [10, 6], [5, 1], [0, 0]. Panics if maxSize is not positive.
+func RangeChunks[T constraints.Integer](from, to, maxSize T) [][2]T {
+	if maxSize <= 0 {
+		panic("chunk size must be positive")
+	}
+
+	var out [][2]T
+	for start := from; ; {
+		end, ok := ranges.StepTowards(start, to, maxSize-1)
+		if !ok {
+			end = to
+		}
+
+		out = append(out, [2]T{start, end})
+
+		if start, ok = ranges.StepTowards(end, to, 1); !ok {
+			return out
+		}
+	}
+}
+
+// RangeBigChunks returns a slice of inclusive [from, to] sub-ranges that cover all values from `from` to `to`.
+// Each sub-range contains at most maxSize values, only the last one can be smaller.
+// Sub-ranges count down if `from` is greater than `to`, e.g. [10, 6], [5, 1], [0, 0]. Panics if maxSize is not positive.
+func RangeBigChunks(from, to, maxSize *big.Int) [][2]*big.Int {
+	if maxSize.Sign() <= 0 {
+		panic("chunk size must be positive")
+	}
+
+	offset := (&big.Int{}).Sub(maxSize, oneBig)
+	next := oneBig
+	if from.Cmp(to) > 0 {
+		offset.Neg(offset)
+		next = big.NewInt(-1)
+	}
+
+	var out [][2]*big.Int
+	for start := (&big.Int{}).Set(from); isBetween(start, from, to); {
+		end := (&big.Int{}).Add(start, offset)
+		if !isBetween(end, from, to) {
+			end.Set(to)
+		}
+
+		out = append(out, [2]*big.Int{start, end})
+		start = (&big.Int{}).Add(end, next)
+	}
+
+	return out
+}
+
+// isBetween returns true if x lies within the inclusive bounds, regardless of their order
+func isBetween(x, bound1, bound2 *big.Int) bool {
+	if bound1.Cmp(bound2) > 0 {
+		bound1, bound2 = bound2, bound1
+	}
+
+	return x.Cmp(bound1) >= 0 && x.Cmp(bound2) <= 0
+}
@@ -0,0 +1,47 @@
+package slices_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func TestRangeStep(t *testing.T) {
+	assert.Equal(t, []int{0, 3, 6, 9}, slices.RangeStep(0, 10, 3))
+	assert.Equal(t, []int{10, 5, 0}, slices.RangeStep(10, 0, 5))
+	assert.Equal(t, []int{4}, slices.RangeStep(4, 4, 5))
+	assert.Equal(t, []uint8{250, 254}, slices.RangeStep[uint8](250, math.MaxUint8, 4))
+	assert.Equal(t, []int8{math.MinInt8 + 1, math.MinInt8}, slices.RangeStep[int8](math.MinInt8+1, math.MinInt8, 1))
+	assert.Panics(t, func() { slices.RangeStep(0, 10, -1) })
+}
+
+func TestRangeBigStep(t *testing.T) {
+	assert.Equal(t, []*big.Int{big.NewInt(-3), big.NewInt(-1), big.NewInt(1), big.NewInt(3)}, slices.RangeBigStep(big.NewInt(-3), big.NewInt(3), big.NewInt(2)))
+	assert.Equal(t, []*big.Int{big.NewInt(3), big.NewInt(-1)}, slices.RangeBigStep(big.NewInt(3), big.NewInt(-3), big.NewInt(4)))
+	assert.Panics(t, func() { slices.RangeBigStep(big.NewInt(0), big.NewInt(1), big.NewInt(0)) })
+}
+
+func TestRangeChunks(t *testing.T) {
+	assert.Equal(t, [][2]int{{1, 4}, {5, 8}, {9, 10}}, slices.RangeChunks(1, 10, 4))
+	assert.Equal(t, [][2]int{{10, 6}, {5, 1}, {0, 0}}, slices.RangeChunks(10, 0, 5))
+	assert.Equal(t, [][2]uint8{{200, math.MaxUint8}}, slices.RangeChunks[uint8](200, math.MaxUint8, 100))
+	assert.Panics(t, func() { slices.RangeChunks(0, 10, 0) })
+}
+
+func TestRangeBigChunks(t *testing.T) {
+	assert.Equal(t, [][2]*big.Int{
+		{big.NewInt(1), big.NewInt(4)},
+		{big.NewInt(5), big.NewInt(8)},
+		{big.NewInt(9), big.NewInt(10)},
+	}, slices.RangeBigChunks(big.NewInt(1), big.NewInt(10), big.NewInt(4)))
+
+	assert.Equal(t, [][2]*big.Int{
+		{big.NewInt(11), big.NewInt(7)},
+		{big.NewInt(6), big.NewInt(2)},
+		{big.NewInt(1), big.NewInt(1)},
+	}, slices.RangeBigChunks(big.NewInt(11), big.NewInt(1), big.NewInt(5)))
+}
@@ -0,0 +1,21 @@
+package slices
+
+import (
+	"iter"
+	stdslices "slices"
+)
+
+// Seq returns a lazy sequence of the elements of the slice. It is the same as the standard library's slices.Values
+func Seq[T any](source []T) iter.Seq[T] {
+	return stdslices.Values(source)
+}
+
+// Seq2 returns a lazy sequence of index-element pairs of the slice. It is the same as the standard library's slices.All
+func Seq2[T any](source []T) iter.Seq2[int, T] {
+	return stdslices.All(source)
+}
+
+// FromSeq collects all elements of the sequence into a new slice. It is the same as the standard library's slices.Collect
+func FromSeq[T any](seq iter.Seq[T]) []T {
+	return stdslices.Collect(seq)
+}
@@ -0,0 +1,31 @@
+package slices_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func TestSeq(t *testing.T) {
+	source := []int{1, 2, 3, 4}
+
+	var out []int
+	for x := range slices.Seq(source) {
+		if x == 3 {
+			break
+		}
+		out = append(out, x)
+	}
+	assert.Equal(t, []int{1, 2}, out)
+
+	assert.Equal(t, source, slices.FromSeq(slices.Seq(source)))
+	assert.Empty(t, slices.FromSeq(slices.Seq([]int{})))
+}
+
+func TestSeq2(t *testing.T) {
+	for i, x := range slices.Seq2([]string{"a", "b", "c"}) {
+		assert.Equal(t, string(rune('a'+i)), x)
+	}
+}
@@ -0,0 +1,47 @@
+package slices
+
+// Intersect returns the distinct elements of first that are also included in second, in the order of first
+func Intersect[T comparable](first, second []T) []T {
+	lookup := toSet(second)
+
+	return Distinct(Filter(first, func(item T) bool {
+		_, ok := lookup[item]
+		return ok
+	}))
+}
+
+// Difference returns the distinct elements of first that are not included in second, in the order of first
+func Difference[T comparable](first, second []T) []T {
+	lookup := toSet(second)
+
+	return Distinct(Filter(first, func(item T) bool {
+		_, ok := lookup[item]
+		return !ok
+	}))
+}
+
+// DistinctBy returns a new slice where entries with duplicate keys are removed. The first entry for each key is kept
+func DistinctBy[T any, K comparable](source []T, key func(T) K) []T {
+	seen := make(map[K]struct{})
+	out := make([]T, 0, len(source))
+	for i := range source {
+		k := key(source[i])
+		if _, ok := seen[k]; ok {
+			continue
+		}
+
+		seen[k] = struct{}{}
+		out = append(out, source[i])
+	}
+
+	return out
+}
+
+func toSet[T comparable](source []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(source))
+	for i := range source {
+		set[source[i]] = struct{}{}
+	}
+
+	return set
+}
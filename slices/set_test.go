@@ -0,0 +1,25 @@
+package slices_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func TestIntersect(t *testing.T) {
+	assert.Equal(t, []int{3, 1}, slices.Intersect([]int{3, 2, 1, 3, 5}, []int{1, 3, 4}))
+	assert.Empty(t, slices.Intersect([]int{1, 2}, nil))
+}
+
+func TestDifference(t *testing.T) {
+	assert.Equal(t, []int{2, 5}, slices.Difference([]int{3, 2, 1, 2, 5}, []int{1, 3, 4}))
+	assert.Equal(t, []int{1, 2}, slices.Difference([]int{1, 2}, nil))
+}
+
+func TestDistinctBy(t *testing.T) {
+	out := slices.DistinctBy([]string{"Axelar", "ethereum", "axelar", "Ethereum", "osmosis"}, strings.ToLower)
+	assert.Equal(t, []string{"Axelar", "ethereum", "osmosis"}, out)
+}
@@ -175,7 +175,9 @@ func ToMap[T1 any, T2 comparable](source []T1, lookup func(T1) T2, strictUniquen
 	return m
 }
 
-// TryCast tries to cast each element of the slice from type T1 to type T2. Elements get filtered out of the cast is unsuccessful.
+// TryCast tries to cast each element of the slice from type T1 to type T2. Elements get filtered out if the cast is unsuccessful.
+//
+// Deprecated: TryCast relies on reflection and silently truncates numeric values. Use CastAs for type assertions and Convert for numeric conversions.
 func TryCast[T1 any, T2 any](source []T1) []T2 {
 	out := make([]T2, 0, cap(source))
 
@@ -202,11 +204,76 @@ func Reverse[T1 any](source []T1) []T1 {
 	return out
 }
 
-// Last returns the last element of a slice, panics if the slice is empty
+// First returns the first element of a slice. Returns false if the slice is empty
+func First[T any](source []T) (T, bool) {
+	return At(source, 0)
+}
+
+// Last returns the last element of a slice, panics if the slice is empty. Use LastOk to handle empty slices safely
 func Last[T any](source []T) T {
 	return source[len(source)-1]
 }
 
+// LastOk returns the last element of a slice. Returns false if the slice is empty
+func LastOk[T any](source []T) (T, bool) {
+	return At(source, -1)
+}
+
+// At returns the element at index i. Negative indices count from the end of the slice, i.e. -1 is the last element.
+// Returns false if the index is out of bounds
+func At[T any](source []T, i int) (T, bool) {
+	if i < 0 {
+		i += len(source)
+	}
+
+	if i < 0 || i >= len(source) {
+		return *new(T), false
+	}
+
+	return source[i], true
+}
+
+// Single returns the only element of a slice. Returns false if the slice does not contain exactly one element
+func Single[T any](source []T) (T, bool) {
+	if len(source) != 1 {
+		return *new(T), false
+	}
+
+	return source[0], true
+}
+
+// Find returns the first element that matches the predicate. Returns false if there is no match
+func Find[T any](source []T, predicate func(T) bool) (T, bool) {
+	i, ok := FindIndex(source, predicate)
+	if !ok {
+		return *new(T), false
+	}
+
+	return source[i], true
+}
+
+// FindIndex returns the index of the first element that matches the predicate. Returns false if there is no match
+func FindIndex[T any](source []T, predicate func(T) bool) (int, bool) {
+	for i := range source {
+		if predicate(source[i]) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// FindLast returns the last element that matches the predicate. Returns false if there is no match
+func FindLast[T any](source []T, predicate func(T) bool) (T, bool) {
+	for i := len(source) - 1; i >= 0; i-- {
+		if predicate(source[i]) {
+			return source[i], true
+		}
+	}
+
+	return *new(T), false
+}
+
 // GroupBy returns a map with given items each group into a slice
 func GroupBy[T any, K comparable](source []T, fn func(T) K) map[K][]T {
 	results := make(map[K][]T)
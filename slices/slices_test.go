@@ -194,3 +194,60 @@ func TestLast(t *testing.T) {
 	source := []int{1, 2, 3, 4, 5, 6}
 	assert.Equal(t, 6, slices.Last(source))
 }
+
+func TestLastOk(t *testing.T) {
+	source := []int{1, 2, 3, 4, 5, 6}
+	assert.Equal(t, 6, funcs.MustOk(slices.LastOk(source)))
+
+	_, ok := slices.LastOk([]int{})
+	assert.False(t, ok)
+}
+
+func TestFirst(t *testing.T) {
+	source := []int{1, 2, 3, 4, 5, 6}
+	assert.Equal(t, 1, funcs.MustOk(slices.First(source)))
+
+	_, ok := slices.First([]int{})
+	assert.False(t, ok)
+}
+
+func TestAt(t *testing.T) {
+	source := []int{1, 2, 3}
+
+	assert.Equal(t, 2, funcs.MustOk(slices.At(source, 1)))
+	assert.Equal(t, 3, funcs.MustOk(slices.At(source, -1)))
+	assert.Equal(t, 1, funcs.MustOk(slices.At(source, -3)))
+
+	_, ok := slices.At(source, 3)
+	assert.False(t, ok)
+	_, ok = slices.At(source, -4)
+	assert.False(t, ok)
+}
+
+func TestSingle(t *testing.T) {
+	assert.Equal(t, 7, funcs.MustOk(slices.Single([]int{7})))
+
+	_, ok := slices.Single([]int{})
+	assert.False(t, ok)
+	_, ok = slices.Single([]int{7, 8})
+	assert.False(t, ok)
+}
+
+func TestFind(t *testing.T) {
+	source := []int{1, 2, 3, 4, 5}
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	assert.Equal(t, 2, funcs.MustOk(slices.Find(source, isEven)))
+	assert.Equal(t, 1, funcs.MustOk(slices.FindIndex(source, isEven)))
+	assert.Equal(t, 4, funcs.MustOk(slices.FindLast(source, isEven)))
+
+	_, ok := slices.Find(source, func(i int) bool { return i > 5 })
+	assert.False(t, ok)
+
+	i, ok := slices.FindIndex(source, func(i int) bool { return i > 5 })
+	assert.False(t, ok)
+	assert.Equal(t, -1, i)
+
+	_, ok = slices.FindLast(source, func(i int) bool { return i > 5 })
+	assert.False(t, ok)
+}
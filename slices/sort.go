@@ -0,0 +1,176 @@
+package slices
+
+import (
+	"cmp"
+	"container/heap"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Comparator returns a negative number if a sorts before b, a positive number if a sorts after b and zero if their order is undetermined
+type Comparator[T any] func(a, b T) int
+
+// Ascending returns a Comparator that orders elements by the given key from smallest to largest
+func Ascending[T any, K constraints.Ordered](key func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Descending returns a Comparator that orders elements by the given key from largest to smallest
+func Descending[T any, K constraints.Ordered](key func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(b), key(a))
+	}
+}
+
+// SortBy returns a new slice with the elements ordered by the given key from smallest to largest. Elements with equal keys keep their original order
+func SortBy[T any, K constraints.Ordered](source []T, key func(T) K) []T {
+	return SortByKeys(source, Ascending(key))
+}
+
+// SortByKeys returns a new slice with the elements ordered by the given comparators. Later comparators are only consulted
+// if all previous ones consider two elements equal. Elements that are equal according to all comparators keep their original order
+func SortByKeys[T any](source []T, comparators ...Comparator[T]) []T {
+	out := make([]T, len(source))
+	copy(out, source)
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return compareAll(out[i], out[j], comparators) < 0
+	})
+
+	return out
+}
+
+// IsSortedBy returns true if the elements of the slice are ordered by the given key from smallest to largest
+func IsSortedBy[T any, K constraints.Ordered](source []T, key func(T) K) bool {
+	for i := 1; i < len(source); i++ {
+		if key(source[i]) < key(source[i-1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MinBy returns the first element with the smallest key. Returns false if the slice is empty
+func MinBy[T any, K constraints.Ordered](source []T, key func(T) K) (T, bool) {
+	return extremeBy(source, key, func(candidate, current K) bool { return candidate < current })
+}
+
+// MaxBy returns the first element with the largest key. Returns false if the slice is empty
+func MaxBy[T any, K constraints.Ordered](source []T, key func(T) K) (T, bool) {
+	return extremeBy(source, key, func(candidate, current K) bool { return candidate > current })
+}
+
+// TopK returns the k elements with the largest keys, ordered from largest to smallest. Among elements with equal keys, earlier ones are preferred
+func TopK[T any, K constraints.Ordered](source []T, k int, key func(T) K) []T {
+	if k <= 0 {
+		return []T{}
+	}
+
+	// min-heap of the best k candidates seen so far, so the worst candidate is always on top
+	h := &topKHeap[T, K]{}
+	for i := range source {
+		entry := topKEntry[T, K]{value: source[i], key: key(source[i]), idx: i}
+
+		if h.Len() < k {
+			heap.Push(h, entry)
+		} else if h.less(h.entries[0], entry) {
+			h.entries[0] = entry
+			heap.Fix(h, 0)
+		}
+	}
+
+	out := make([]T, h.Len())
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = heap.Pop(h).(topKEntry[T, K]).value
+	}
+
+	return out
+}
+
+// BinarySearchBy searches for the target key in a slice that is sorted by the given key from smallest to largest.
+// Returns the index of the first element with a key equal to target and true if it is found,
+// otherwise the index at which target would be inserted and false.
+func BinarySearchBy[T any, K constraints.Ordered](source []T, target K, key func(T) K) (int, bool) {
+	i := sort.Search(len(source), func(i int) bool { return key(source[i]) >= target })
+
+	return i, i < len(source) && key(source[i]) == target
+}
+
+// MergeSortedBy merges two slices that are each sorted by the given key from smallest to largest into a new sorted slice.
+// For equal keys, elements of the first slice are placed before elements of the second
+func MergeSortedBy[T any, K constraints.Ordered](first, second []T, key func(T) K) []T {
+	out := make([]T, 0, len(first)+len(second))
+
+	i, j := 0, 0
+	for i < len(first) && j < len(second) {
+		if key(second[j]) < key(first[i]) {
+			out = append(out, second[j])
+			j++
+		} else {
+			out = append(out, first[i])
+			i++
+		}
+	}
+
+	out = append(out, first[i:]...)
+	return append(out, second[j:]...)
+}
+
+func compareAll[T any](a, b T, comparators []Comparator[T]) int {
+	for _, compare := range comparators {
+		if c := compare(a, b); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+func extremeBy[T any, K constraints.Ordered](source []T, key func(T) K, better func(candidate, current K) bool) (T, bool) {
+	if len(source) == 0 {
+		return *new(T), false
+	}
+
+	best, bestKey := source[0], key(source[0])
+	for i := 1; i < len(source); i++ {
+		if k := key(source[i]); better(k, bestKey) {
+			best, bestKey = source[i], k
+		}
+	}
+
+	return best, true
+}
+
+type topKEntry[T any, K constraints.Ordered] struct {
+	value T
+	key   K
+	idx   int
+}
+
+type topKHeap[T any, K constraints.Ordered] struct {
+	entries []topKEntry[T, K]
+}
+
+// less returns true if a is a worse candidate than b, i.e. it has a smaller key or an equal key but appears later in the source
+func (h *topKHeap[T, K]) less(a, b topKEntry[T, K]) bool {
+	if a.key != b.key {
+		return a.key < b.key
+	}
+	return a.idx > b.idx
+}
+
+func (h *topKHeap[T, K]) Len() int           { return len(h.entries) }
+func (h *topKHeap[T, K]) Less(i, j int) bool { return h.less(h.entries[i], h.entries[j]) }
+func (h *topKHeap[T, K]) Swap(i, j int)      { h.entries[i], h.entries[j] = h.entries[j], h.entries[i] }
+func (h *topKHeap[T, K]) Push(x any)         { h.entries = append(h.entries, x.(topKEntry[T, K])) }
+
+func (h *topKHeap[T, K]) Pop() any {
+	last := len(h.entries) - 1
+	x := h.entries[last]
+	h.entries = h.entries[:last]
+	return x
+}
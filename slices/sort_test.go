@@ -0,0 +1,90 @@
+package slices_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+type validator struct {
+	address string
+	power   int
+}
+
+func power(v validator) int      { return v.power }
+func address(v validator) string { return v.address }
+
+func TestSortBy(t *testing.T) {
+	source := []string{"ccc", "a", "bb", "d"}
+
+	assert.Equal(t, []string{"a", "d", "bb", "ccc"}, slices.SortBy(source, func(s string) int { return len(s) }))
+	assert.Equal(t, []string{"ccc", "a", "bb", "d"}, source)
+}
+
+func TestSortByKeys(t *testing.T) {
+	source := []validator{{"c", 10}, {"a", 5}, {"b", 10}, {"d", 5}}
+
+	assert.Equal(t,
+		[]validator{{"b", 10}, {"c", 10}, {"a", 5}, {"d", 5}},
+		slices.SortByKeys(source, slices.Descending(power), slices.Ascending(address)))
+
+	// stable if all comparators are equal
+	assert.Equal(t,
+		[]validator{{"a", 5}, {"d", 5}, {"c", 10}, {"b", 10}},
+		slices.SortByKeys(source, slices.Ascending(power)))
+}
+
+func TestIsSortedBy(t *testing.T) {
+	assert.True(t, slices.IsSortedBy([]validator{{"a", 1}, {"b", 1}, {"c", 2}}, power))
+	assert.False(t, slices.IsSortedBy([]validator{{"a", 2}, {"b", 1}}, power))
+	assert.True(t, slices.IsSortedBy([]validator{}, power))
+}
+
+func TestMinMaxBy(t *testing.T) {
+	source := []validator{{"a", 5}, {"b", 1}, {"c", 10}, {"d", 1}, {"e", 10}}
+
+	assert.Equal(t, validator{"b", 1}, funcs.MustOk(slices.MinBy(source, power)))
+	assert.Equal(t, validator{"c", 10}, funcs.MustOk(slices.MaxBy(source, power)))
+
+	_, ok := slices.MinBy([]validator{}, power)
+	assert.False(t, ok)
+	_, ok = slices.MaxBy([]validator{}, power)
+	assert.False(t, ok)
+}
+
+func TestTopK(t *testing.T) {
+	source := []validator{{"a", 5}, {"b", 1}, {"c", 10}, {"d", 7}, {"e", 10}, {"f", 7}}
+
+	assert.Equal(t, []validator{{"c", 10}, {"e", 10}, {"d", 7}}, slices.TopK(source, 3, power))
+	assert.Equal(t, slices.SortByKeys(source, slices.Descending(power)), slices.TopK(source, 10, power))
+	assert.Empty(t, slices.TopK(source, 0, power))
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	source := []validator{{"a", 1}, {"b", 3}, {"c", 3}, {"d", 7}}
+
+	i, ok := slices.BinarySearchBy(source, 3, power)
+	assert.True(t, ok)
+	assert.Equal(t, 1, i)
+
+	i, ok = slices.BinarySearchBy(source, 5, power)
+	assert.False(t, ok)
+	assert.Equal(t, 3, i)
+
+	i, ok = slices.BinarySearchBy(source, 10, power)
+	assert.False(t, ok)
+	assert.Equal(t, 4, i)
+}
+
+func TestMergeSortedBy(t *testing.T) {
+	first := []validator{{"a", 1}, {"b", 3}, {"c", 8}}
+	second := []validator{{"x", 2}, {"y", 3}, {"z", 10}}
+
+	assert.Equal(t,
+		[]validator{{"a", 1}, {"x", 2}, {"b", 3}, {"y", 3}, {"c", 8}, {"z", 10}},
+		slices.MergeSortedBy(first, second, power))
+	assert.Equal(t, first, slices.MergeSortedBy(first, nil, power))
+}
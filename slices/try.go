@@ -0,0 +1,154 @@
+package slices
+
+import (
+	"errors"
+	"fmt"
+)
+
+// IndexedError is returned by fallible slice functions and holds the index of the element that caused the error
+type IndexedError struct {
+	Index int
+	Err   error
+}
+
+// Error implements the error interface
+func (e IndexedError) Error() string {
+	return fmt.Sprintf("element %d: %s", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e IndexedError) Unwrap() error {
+	return e.Err
+}
+
+// TryMap maps a slice of T to a slice of S. Stops at the first error and returns it as an IndexedError
+func TryMap[T, S any](source []T, f func(T) (S, error)) ([]S, error) {
+	out := make([]S, len(source))
+
+	for i := range source {
+		s, err := f(source[i])
+		if err != nil {
+			return nil, IndexedError{Index: i, Err: err}
+		}
+
+		out[i] = s
+	}
+
+	return out, nil
+}
+
+// MapAll maps a slice of T to a slice of S. In contrast to TryMap, it applies f to all elements and joins all errors.
+// Failed elements are left as zero values in the output slice
+func MapAll[T, S any](source []T, f func(T) (S, error)) ([]S, error) {
+	out := make([]S, len(source))
+	var errs []error
+
+	for i := range source {
+		s, err := f(source[i])
+		if err != nil {
+			errs = append(errs, IndexedError{Index: i, Err: err})
+			continue
+		}
+
+		out[i] = s
+	}
+
+	return out, errors.Join(errs...)
+}
+
+// TryFlatMap composes TryMap and Flatten
+func TryFlatMap[T, S any](source []T, f func(T) ([]S, error)) ([]S, error) {
+	out, err := TryMap(source, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return Flatten(out), nil
+}
+
+// FlatMapAll composes MapAll and Flatten
+func FlatMapAll[T, S any](source []T, f func(T) ([]S, error)) ([]S, error) {
+	out, err := MapAll(source, f)
+	return Flatten(out), err
+}
+
+// TryReduce performs a reduction to a single value of the source slice according to the given function.
+// Stops at the first error and returns it as an IndexedError
+func TryReduce[T, S any](source []T, initial S, f func(current S, element T) (S, error)) (S, error) {
+	v := initial
+
+	for i := range source {
+		var err error
+		if v, err = f(v, source[i]); err != nil {
+			return *new(S), IndexedError{Index: i, Err: err}
+		}
+	}
+
+	return v, nil
+}
+
+// TryFilter returns a new slice that only contains elements that match the predicate.
+// Stops at the first error and returns it as an IndexedError
+func TryFilter[T any](source []T, predicate func(T) (bool, error)) ([]T, error) {
+	out := make([]T, 0, cap(source))
+
+	for i := range source {
+		ok, err := predicate(source[i])
+		if err != nil {
+			return nil, IndexedError{Index: i, Err: err}
+		}
+
+		if ok {
+			out = append(out, source[i])
+		}
+	}
+
+	return out, nil
+}
+
+// FilterAll returns a new slice that only contains elements that match the predicate.
+// In contrast to TryFilter, it applies the predicate to all elements and joins all errors. Failed elements are filtered out
+func FilterAll[T any](source []T, predicate func(T) (bool, error)) ([]T, error) {
+	out := make([]T, 0, cap(source))
+	var errs []error
+
+	for i := range source {
+		ok, err := predicate(source[i])
+		if err != nil {
+			errs = append(errs, IndexedError{Index: i, Err: err})
+			continue
+		}
+
+		if ok {
+			out = append(out, source[i])
+		}
+	}
+
+	return out, errors.Join(errs...)
+}
+
+// TryToMap returns a map from the given slice with keys associated by the lookup function.
+// Stops at the first error and returns it as an IndexedError. If strictUniqueness is set, colliding keys result in an error,
+// otherwise values for colliding keys are overridden.
+func TryToMap[T1 any, T2 comparable](source []T1, lookup func(T1) (T2, error), strictUniqueness ...bool) (map[T2]T1, error) {
+	m := make(map[T2]T1, len(source))
+
+	strict := len(strictUniqueness) > 0 && strictUniqueness[0]
+
+	for i := range source {
+		key, err := lookup(source[i])
+		if err != nil {
+			return nil, IndexedError{Index: i, Err: err}
+		}
+
+		if strict {
+			if value, ok := m[key]; ok {
+				return nil, IndexedError{Index: i, Err: fmt.Errorf("key %v is not unique, points to %v and %v", key, value, source[i])}
+			}
+		}
+
+		m[key] = source[i]
+	}
+
+	return m, nil
+}
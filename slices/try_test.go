@@ -0,0 +1,105 @@
+package slices_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func TestTryMap(t *testing.T) {
+	out, err := slices.TryMap([]string{"1", "2", "3"}, strconv.Atoi)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, out)
+
+	calls := 0
+	_, err = slices.TryMap([]string{"1", "a", "b"}, func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	})
+	assert.Equal(t, 2, calls)
+
+	var indexedErr slices.IndexedError
+	assert.ErrorAs(t, err, &indexedErr)
+	assert.Equal(t, 1, indexedErr.Index)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+}
+
+func TestMapAll(t *testing.T) {
+	out, err := slices.MapAll([]string{"1", "a", "3", "b"}, strconv.Atoi)
+	assert.Equal(t, []int{1, 0, 3, 0}, out)
+	assert.ErrorContains(t, err, "element 1")
+	assert.ErrorContains(t, err, "element 3")
+
+	out, err = slices.MapAll([]string{"1", "2"}, strconv.Atoi)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, out)
+}
+
+func TestTryFlatMap(t *testing.T) {
+	repeat := func(s string) ([]int, error) {
+		i, err := strconv.Atoi(s)
+		return []int{i, i}, err
+	}
+
+	out, err := slices.TryFlatMap([]string{"1", "2"}, repeat)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 1, 2, 2}, out)
+
+	_, err = slices.TryFlatMap([]string{"1", "a"}, repeat)
+	assert.Error(t, err)
+
+	out, err = slices.FlatMapAll([]string{"a", "2", "b"}, repeat)
+	assert.Equal(t, []int{2, 2}, out)
+	assert.Len(t, err.(interface{ Unwrap() []error }).Unwrap(), 2)
+}
+
+func TestTryReduce(t *testing.T) {
+	sum := func(v int, s string) (int, error) {
+		i, err := strconv.Atoi(s)
+		return v + i, err
+	}
+
+	total, err := slices.TryReduce([]string{"1", "2", "3"}, 0, sum)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, total)
+
+	total, err = slices.TryReduce([]string{"1", "2", "x"}, 0, sum)
+	assert.Equal(t, slices.IndexedError{Index: 2, Err: errors.Unwrap(err)}, err)
+	assert.Zero(t, total)
+}
+
+func TestTryFilter(t *testing.T) {
+	isEven := func(s string) (bool, error) {
+		i, err := strconv.Atoi(s)
+		return i%2 == 0, err
+	}
+
+	out, err := slices.TryFilter([]string{"1", "2", "3", "4"}, isEven)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "4"}, out)
+
+	_, err = slices.TryFilter([]string{"1", "2", "x", "4"}, isEven)
+	assert.ErrorContains(t, err, "element 2")
+
+	out, err = slices.FilterAll([]string{"1", "2", "x", "4"}, isEven)
+	assert.Equal(t, []string{"2", "4"}, out)
+	assert.ErrorContains(t, err, "element 2")
+}
+
+func TestTryToMap(t *testing.T) {
+	source := []string{"1", "2", "01"}
+
+	m, err := slices.TryToMap(source, strconv.Atoi)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]string{1: "01", 2: "2"}, m)
+
+	_, err = slices.TryToMap(source, strconv.Atoi, true)
+	assert.ErrorContains(t, err, "element 2")
+
+	_, err = slices.TryToMap([]string{"1", "x"}, strconv.Atoi)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+}
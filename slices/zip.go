@@ -0,0 +1,126 @@
+package slices
+
+import (
+	"fmt"
+)
+
+// Pair holds two values of potentially different types
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs up the elements of both slices by index. Returns an error if the slices have different lengths
+func Zip[A, B any](first []A, second []B) ([]Pair[A, B], error) {
+	return ZipWith(first, second, newPair[A, B])
+}
+
+// ZipWith combines the elements of both slices by index with the given function. Returns an error if the slices have different lengths
+func ZipWith[A, B, S any](first []A, second []B, f func(A, B) S) ([]S, error) {
+	if len(first) != len(second) {
+		return nil, fmt.Errorf("cannot zip slices of different lengths %d and %d", len(first), len(second))
+	}
+
+	return ZipWithShortest(first, second, f), nil
+}
+
+// ZipShortest pairs up the elements of both slices by index. Excess elements of the longer slice are ignored
+func ZipShortest[A, B any](first []A, second []B) []Pair[A, B] {
+	return ZipWithShortest(first, second, newPair[A, B])
+}
+
+// ZipWithShortest combines the elements of both slices by index with the given function. Excess elements of the longer slice are ignored
+func ZipWithShortest[A, B, S any](first []A, second []B, f func(A, B) S) []S {
+	out := make([]S, min(len(first), len(second)))
+
+	for i := range out {
+		out[i] = f(first[i], second[i])
+	}
+
+	return out
+}
+
+// Unzip splits a slice of pairs into a slice of their first and a slice of their second values
+func Unzip[A, B any](source []Pair[A, B]) ([]A, []B) {
+	first := make([]A, len(source))
+	second := make([]B, len(source))
+
+	for i := range source {
+		first[i] = source[i].First
+		second[i] = source[i].Second
+	}
+
+	return first, second
+}
+
+// CartesianProduct returns all pairs of elements from both slices, ordered by the index of the first and then the second slice
+func CartesianProduct[A, B any](first []A, second []B) []Pair[A, B] {
+	out := make([]Pair[A, B], 0, len(first)*len(second))
+
+	for i := range first {
+		for j := range second {
+			out = append(out, newPair(first[i], second[j]))
+		}
+	}
+
+	return out
+}
+
+// Pairwise returns all pairs of consecutive elements, e.g. [1 2 3] results in [(1, 2) (2, 3)]
+func Pairwise[T any](source []T) []Pair[T, T] {
+	if len(source) < 2 {
+		return []Pair[T, T]{}
+	}
+
+	return ZipWithShortest(source[:len(source)-1], source[1:], newPair[T, T])
+}
+
+// Combinations returns all selections of k elements in the order they appear in the source slice,
+// e.g. [1 2 3] with k=2 results in [[1 2] [1 3] [2 3]]. Returns no combinations if k is negative or larger than the slice
+func Combinations[T any](source []T, k int) [][]T {
+	if k < 0 || k > len(source) {
+		return [][]T{}
+	}
+
+	var out [][]T
+	indices := Expand(func(idx int) int { return idx }, k)
+	for {
+		out = append(out, Map(indices, func(idx int) T { return source[idx] }))
+
+		// find the rightmost index that can still be moved forward
+		i := k - 1
+		for i >= 0 && indices[i] == len(source)-k+i {
+			i--
+		}
+
+		if i < 0 {
+			return out
+		}
+
+		indices[i]++
+		for j := i + 1; j < k; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+	}
+}
+
+// Permutations returns all orderings of the elements of the slice, in lexicographic order of the source indices
+func Permutations[T any](source []T) [][]T {
+	if len(source) == 0 {
+		return [][]T{{}}
+	}
+
+	var out [][]T
+	for i := range source {
+		rest := Concat(source[:i:i], source[i+1:])
+		for _, permutation := range Permutations(rest) {
+			out = append(out, Concat([]T{source[i]}, permutation))
+		}
+	}
+
+	return out
+}
+
+func newPair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{First: a, Second: b}
+}
@@ -0,0 +1,74 @@
+package slices_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/utils/chans"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func TestZip(t *testing.T) {
+	out, err := slices.Zip([]int{1, 2}, []string{"a", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, []slices.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}, out)
+
+	_, err = slices.Zip([]int{1, 2}, []string{"a"})
+	assert.Error(t, err)
+
+	assert.Equal(t, []slices.Pair[int, string]{{First: 1, Second: "a"}}, slices.ZipShortest([]int{1, 2}, []string{"a"}))
+}
+
+func TestZipWith(t *testing.T) {
+	expected := []bool{true, false, true}
+	actual := []bool{true, true, true}
+
+	matches, err := slices.ZipWith(expected, actual, func(a, b bool) bool { return a == b })
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, true}, matches)
+
+	_, err = slices.ZipWith(expected, actual[:2], func(a, b bool) bool { return a == b })
+	assert.Error(t, err)
+
+	assert.Equal(t, []int{11, 22}, slices.ZipWithShortest([]int{1, 2, 3}, []int{10, 20}, func(a, b int) int { return a + b }))
+}
+
+func TestUnzip(t *testing.T) {
+	pairs, err := chans.Collect(context.Background(), chans.Zip(context.Background(), chans.Of(chans.FromValues(1, 2)), chans.Of(chans.FromValues("a", "b"))))
+	assert.NoError(t, err)
+
+	numbers, letters := slices.Unzip(pairs)
+	assert.Equal(t, []int{1, 2}, numbers)
+	assert.Equal(t, []string{"a", "b"}, letters)
+}
+
+func TestCartesianProduct(t *testing.T) {
+	assert.Equal(t, []slices.Pair[int, string]{
+		{First: 1, Second: "a"},
+		{First: 1, Second: "b"},
+		{First: 2, Second: "a"},
+		{First: 2, Second: "b"},
+	}, slices.CartesianProduct([]int{1, 2}, []string{"a", "b"}))
+	assert.Empty(t, slices.CartesianProduct([]int{1, 2}, []string{}))
+}
+
+func TestPairwise(t *testing.T) {
+	assert.Equal(t, []slices.Pair[int, int]{{First: 1, Second: 2}, {First: 2, Second: 3}}, slices.Pairwise([]int{1, 2, 3}))
+	assert.Empty(t, slices.Pairwise([]int{1}))
+}
+
+func TestCombinations(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}, slices.Combinations([]int{1, 2, 3, 4}, 2))
+	assert.Equal(t, [][]int{{1, 2, 3}}, slices.Combinations([]int{1, 2, 3}, 3))
+	assert.Equal(t, [][]int{{}}, slices.Combinations([]int{1, 2, 3}, 0))
+	assert.Empty(t, slices.Combinations([]int{1, 2, 3}, 4))
+	assert.Empty(t, slices.Combinations([]int{1, 2, 3}, -1))
+}
+
+func TestPermutations(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}, slices.Permutations([]int{1, 2, 3}))
+	assert.Equal(t, [][]int{{}}, slices.Permutations([]int{}))
+	assert.Len(t, slices.Permutations([]int{1, 2, 3, 4, 5}), 120)
+}